@@ -99,6 +99,24 @@ type Session interface {
 		opts ...options.DescribeSemaphoreOption,
 	) (*SemaphoreDescription, error)
 
+	// WatchSemaphore returns the state of the semaphore and the channel of its changes. The server notifies the session
+	// about changes of data and owners of the semaphore (see options.WithWatchData and options.WithWatchOwners), on
+	// every notification the semaphore is described again and the new state is sent to the channel. If the reader of
+	// the channel is slow, intermediate states are skipped and the latest state is sent only.
+	//
+	// The watch survives reconnects of the session: after reconnect the semaphore is described again and the state is
+	// sent to the channel if it was changed.
+	//
+	// The channel is closed when the ctx is canceled, the session is closed or the semaphore can't be described, for
+	// example if it was deleted.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	WatchSemaphore(
+		ctx context.Context,
+		name string,
+		opts ...options.DescribeSemaphoreOption,
+	) (*SemaphoreDescription, <-chan *SemaphoreDescription, error)
+
 	// AcquireSemaphore acquires the semaphore. If you acquire an ephemeral semaphore (see options.WithEphemeral), its
 	// limit will be set to MaxSemaphoreLimit. Later requests override previous operations with the same semaphore, e.g.
 	// to reduce acquired count, change timeout or attached data.
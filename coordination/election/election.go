@@ -0,0 +1,253 @@
+package election
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// ErrNoLeader is returned by Election.Leader if nobody holds the leadership at the moment.
+var ErrNoLeader = xerrors.Wrap(errors.New("ydb: election has no leader"))
+
+// Election is a leader election on top of the coordination service semaphore. The semaphore is created with the
+// limit 1 on the first campaign or observation and the leader is the only owner of the semaphore. Candidates wait
+// for the leadership in the FIFO order (see coordination.Session.AcquireSemaphore).
+//
+// All campaigns and observations are made within the coordination session provided. The caller is responsible for
+// the session lifecycle: once the session is closed or lost, the leadership of the session is lost too.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Election struct {
+	session coordination.Session
+	name    string
+	trace   *trace.Coordination
+}
+
+// Leader describes the current leader of the election.
+type Leader struct {
+	// SessionID is the id of the coordination session which holds the leadership.
+	SessionID uint64
+
+	// OrderID is a monotonically increasing id of the campaign which won the election.
+	OrderID uint64
+
+	// Data is the candidate data passed to Election.Campaign.
+	Data []byte
+}
+
+// Option is an option of the Election.
+type Option func(e *Election)
+
+// WithTrace appends the trace of election events to early defined traces.
+func WithTrace(t *trace.Coordination, opts ...trace.CoordinationComposeOption) Option {
+	return func(e *Election) {
+		e.trace = e.trace.Compose(t, opts...)
+	}
+}
+
+// New creates an election on the semaphore with the given name within the coordination session.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(session coordination.Session, name string, opts ...Option) *Election {
+	e := &Election{
+		session: session,
+		name:    name,
+		trace:   &trace.Coordination{},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(e)
+		}
+	}
+
+	return e
+}
+
+// Campaign blocks until the session is elected as the leader, the ctx is canceled or the session is closed. The data
+// is visible to all observers of the election while the session is the leader.
+func (e *Election) Campaign(ctx context.Context, data []byte) (_ *Leadership, finalErr error) {
+	onDone := trace.CoordinationOnElectionCampaign(e.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/coordination/election.(*Election).Campaign"),
+		e.name, e.session.SessionID(),
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	if err := e.createSemaphore(ctx); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	lease, err := e.session.AcquireSemaphore(ctx, e.name, 1, options.WithAcquireData(data))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	l := &Leadership{
+		election: e,
+		lease:    lease,
+		data:     data,
+		resigned: make(chan struct{}),
+	}
+	go l.watchLease()
+
+	return l, nil
+}
+
+// Leader returns the current leader of the election or ErrNoLeader if nobody holds the leadership.
+func (e *Election) Leader(ctx context.Context) (*Leader, error) {
+	desc, err := e.session.DescribeSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	leader := leaderFromDescription(desc)
+	if leader == nil {
+		return nil, xerrors.WithStackTrace(ErrNoLeader)
+	}
+
+	return leader, nil
+}
+
+// Observe returns the channel of leaders of the election. The current leader is sent to the channel first, then every
+// change of the leadership is sent. A nil value means the election has no leader at the moment.
+//
+// The channel is closed when the ctx is canceled or the session is closed.
+func (e *Election) Observe(ctx context.Context) (<-chan *Leader, error) {
+	if err := e.createSemaphore(ctx); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	desc, changes, err := e.session.WatchSemaphore(ctx, e.name,
+		options.WithDescribeOwners(true),
+		options.WithWatchOwners(true),
+		options.WithWatchData(false),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	leaders := make(chan *Leader)
+	go func() {
+		defer close(leaders)
+
+		leader := leaderFromDescription(desc)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case leaders <- leader:
+			}
+
+			for {
+				desc, ok := <-changes
+				if !ok {
+					return
+				}
+				if next := leaderFromDescription(desc); !sameLeader(leader, next) {
+					leader = next
+
+					break
+				}
+			}
+		}
+	}()
+
+	return leaders, nil
+}
+
+func (e *Election) createSemaphore(ctx context.Context) error {
+	err := e.session.CreateSemaphore(ctx, e.name, 1)
+	if err != nil && !xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return err
+	}
+
+	return nil
+}
+
+// Leadership is the result of the won campaign. It is valid until its context is canceled.
+type Leadership struct {
+	election *Election
+	lease    coordination.Lease
+	data     []byte
+
+	resignOnce sync.Once
+	resignErr  error
+	resigned   chan struct{}
+}
+
+// Context returns the context of the leadership. It is canceled when the leadership is lost: the leader resigned,
+// the session was closed or lost.
+func (l *Leadership) Context() context.Context {
+	return l.lease.Context()
+}
+
+// Data returns the candidate data the leadership was won with.
+func (l *Leadership) Data() []byte {
+	return l.data
+}
+
+// Resign gives up the leadership and lets the next candidate be elected. Subsequent calls return the result of the
+// first one.
+func (l *Leadership) Resign() error {
+	l.resignOnce.Do(func() {
+		close(l.resigned)
+
+		onDone := trace.CoordinationOnElectionResign(l.election.trace,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/coordination/election.(*Leadership).Resign"),
+			l.election.name, l.election.session.SessionID(),
+		)
+		l.resignErr = l.lease.Release()
+		if l.resignErr != nil {
+			l.resignErr = xerrors.WithStackTrace(l.resignErr)
+		}
+		onDone(l.resignErr)
+	})
+
+	return l.resignErr
+}
+
+func (l *Leadership) watchLease() {
+	select {
+	case <-l.resigned:
+	case <-l.lease.Context().Done():
+		select {
+		case <-l.resigned:
+		default:
+			trace.CoordinationOnElectionLeadershipLost(l.election.trace,
+				l.election.name, l.election.session.SessionID(),
+			)
+		}
+	}
+}
+
+func leaderFromDescription(desc *coordination.SemaphoreDescription) *Leader {
+	if desc == nil || len(desc.Owners) == 0 {
+		return nil
+	}
+
+	owner := desc.Owners[0]
+
+	return &Leader{
+		SessionID: owner.SessionID,
+		OrderID:   owner.OrderID,
+		Data:      owner.Data,
+	}
+}
+
+func sameLeader(lhs, rhs *Leader) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == rhs
+	}
+
+	return lhs.SessionID == rhs.SessionID && lhs.OrderID == rhs.OrderID && bytes.Equal(lhs.Data, rhs.Data)
+}
@@ -0,0 +1,194 @@
+package election
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const testElectionName = "leader"
+
+func TestElection(t *testing.T) {
+	t.Run("CampaignAndResign", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		node := xtest.NewCoordinationNode()
+		s1 := newTestSession(t, node)
+		s2 := newTestSession(t, node)
+		e1 := New(s1, testElectionName)
+		e2 := New(s2, testElectionName)
+
+		_, err := e1.Leader(ctx)
+		require.ErrorIs(t, err, ErrNoLeader)
+
+		l1, err := e1.Campaign(ctx, []byte("first"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("first"), l1.Data())
+
+		leader, err := e2.Leader(ctx)
+		require.NoError(t, err)
+		require.Equal(t, s1.SessionID(), leader.SessionID)
+		require.Equal(t, []byte("first"), leader.Data)
+
+		elected := make(chan *Leadership, 1)
+		go func() {
+			l2, _ := e2.Campaign(ctx, []byte("second"))
+			elected <- l2
+		}()
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return node.WaitersCount(testElectionName) == 1
+		})
+		require.Empty(t, elected)
+
+		require.NoError(t, l1.Resign())
+		<-l1.Context().Done()
+
+		l2 := receive(t, elected)
+		require.NotNil(t, l2)
+		require.NoError(t, l2.Context().Err())
+
+		leader, err = e1.Leader(ctx)
+		require.NoError(t, err)
+		require.Equal(t, s2.SessionID(), leader.SessionID)
+		require.Equal(t, []byte("second"), leader.Data)
+	})
+	t.Run("CampaignCanceled", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		node := xtest.NewCoordinationNode()
+		s1 := newTestSession(t, node)
+		s2 := newTestSession(t, node)
+
+		_, err := New(s1, testElectionName).Campaign(ctx, nil)
+		require.NoError(t, err)
+
+		campaignCtx, cancel := context.WithCancel(ctx)
+		campaignErr := make(chan error, 1)
+		go func() {
+			_, err := New(s2, testElectionName).Campaign(campaignCtx, nil)
+			campaignErr <- err
+		}()
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return node.WaitersCount(testElectionName) == 1
+		})
+		cancel()
+
+		require.ErrorIs(t, receive(t, campaignErr), context.Canceled)
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return node.WaitersCount(testElectionName) == 0
+		})
+	})
+	t.Run("Observe", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		node := xtest.NewCoordinationNode()
+		s1 := newTestSession(t, node)
+		s2 := newTestSession(t, node)
+		observer := newTestSession(t, node)
+
+		observeCtx, cancel := context.WithCancel(ctx)
+		leaders, err := New(observer, testElectionName).Observe(observeCtx)
+		require.NoError(t, err)
+		require.Nil(t, receive(t, leaders))
+
+		l1, err := New(s1, testElectionName).Campaign(ctx, []byte("first"))
+		require.NoError(t, err)
+		leader := receive(t, leaders)
+		require.Equal(t, s1.SessionID(), leader.SessionID)
+		require.Equal(t, []byte("first"), leader.Data)
+
+		// the watch must survive reconnect of the observer session
+		observer.Reconnect()
+
+		go func() {
+			_, _ = New(s2, testElectionName).Campaign(ctx, []byte("second"))
+		}()
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return node.WaitersCount(testElectionName) == 1
+		})
+		require.NoError(t, l1.Resign())
+
+		leader = receive(t, leaders)
+		require.Equal(t, s2.SessionID(), leader.SessionID)
+		require.Equal(t, []byte("second"), leader.Data)
+
+		cancel()
+		for range leaders {
+		}
+	})
+	t.Run("LeadershipLost", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		node := xtest.NewCoordinationNode()
+		s := newTestSession(t, node)
+
+		var (
+			mu       sync.Mutex
+			resigned int
+			lost     int
+		)
+		e := New(s, testElectionName, WithTrace(&trace.Coordination{
+			OnElectionResign: func(
+				trace.CoordinationElectionResignStartInfo,
+			) func(
+				trace.CoordinationElectionResignDoneInfo,
+			) {
+				mu.Lock()
+				defer mu.Unlock()
+				resigned++
+
+				return nil
+			},
+			OnElectionLeadershipLost: func(info trace.CoordinationElectionLeadershipLostInfo) {
+				mu.Lock()
+				defer mu.Unlock()
+				lost++
+			},
+		}))
+
+		l, err := e.Campaign(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, l.Resign())
+		require.NoError(t, l.Resign())
+
+		l, err = e.Campaign(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.Close(ctx))
+		<-l.Context().Done()
+
+		xtest.SpinWaitCondition(t, &mu, func() bool {
+			return lost == 1
+		})
+		require.Equal(t, 1, resigned)
+	})
+}
+
+func receive[T any](t testing.TB, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("no value received from channel")
+	}
+
+	return *new(T)
+}
+
+func newTestSession(t testing.TB, node *xtest.CoordinationNode) coordination.Session {
+	ctx := xtest.Context(t)
+	client := internalCoordination.New(ctx, node, config.New())
+	s, err := client.Session(ctx, "/local/node")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close(ctx)
+	})
+
+	return s
+}
@@ -0,0 +1,42 @@
+package election_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/election"
+)
+
+//nolint:errcheck
+func Example() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+
+	session, err := db.Coordination().Session(ctx, "/local/test")
+	if err != nil {
+		fmt.Printf("failed to create session: %v", err)
+
+		return
+	}
+	defer session.Close(ctx)
+
+	e := election.New(session, "leader")
+
+	leadership, err := e.Campaign(ctx, []byte("candidate-1"))
+	if err != nil {
+		fmt.Printf("failed to campaign: %v", err)
+
+		return
+	}
+	defer leadership.Resign()
+
+	// do the leader work until the leadership is lost
+	<-leadership.Context().Done()
+}
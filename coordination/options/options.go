@@ -169,5 +169,21 @@ func WithDescribeWaiters(describeWaiters bool) DescribeSemaphoreOption {
 	}
 }
 
+// WithWatchData return a DescribeSemaphoreOption which causes server notify session about changes of
+// user-defined data of the semaphore. It is enabled by default in WatchSemaphore.
+func WithWatchData(watchData bool) DescribeSemaphoreOption {
+	return func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		c.WatchData = watchData
+	}
+}
+
+// WithWatchOwners return a DescribeSemaphoreOption which causes server notify session about changes of
+// owners of the semaphore. It is enabled by default in WatchSemaphore.
+func WithWatchOwners(watchOwners bool) DescribeSemaphoreOption {
+	return func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		c.WatchOwners = watchOwners
+	}
+}
+
 // DescribeSemaphoreOption configures how we update a semaphore.
 type DescribeSemaphoreOption func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore)
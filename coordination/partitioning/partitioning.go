@@ -0,0 +1,271 @@
+package partitioning
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const defaultSemaphorePrefix = "partitioning/"
+
+// Handler processes the shard assigned to the worker. The ctx is canceled when the shard is reassigned to another
+// worker, the worker is stopped or the coordination session is lost. The shard lease is held until the ctx is canceled
+// even if the handler returns earlier, and released after the handler returns.
+type Handler func(ctx context.Context, shard string)
+
+// Option is an option of the Run function.
+type Option func(c *config)
+
+type config struct {
+	semaphorePrefix string
+	sessionOptions  []options.SessionOption
+}
+
+// WithSemaphorePrefix returns an Option that specifies the prefix of names of the semaphores used for the
+// partitioning. Workers with different sets of shards on the same coordination node must use different prefixes.
+//
+// If this is not set, the prefix "partitioning/" is used.
+func WithSemaphorePrefix(prefix string) Option {
+	return func(c *config) {
+		c.semaphorePrefix = prefix
+	}
+}
+
+// WithSessionOptions returns an Option that specifies the options of the coordination sessions created by the worker.
+func WithSessionOptions(opts ...options.SessionOption) Option {
+	return func(c *config) {
+		c.sessionOptions = append(c.sessionOptions, opts...)
+	}
+}
+
+// Run starts a worker which processes shards on the coordination node path. The shards are distributed among all
+// live workers started with the same node path and semaphore prefix: every worker registers itself as an owner of the
+// members semaphore and watches the list of the members. The shard is assigned to the worker with the highest
+// rendezvous hash of the shard key and the worker session id, so only the shards of the joined or left worker are
+// moved on rebalancing. Exclusive ownership of the shard is guaranteed by the ephemeral semaphore of the shard.
+//
+// If the coordination session is lost, all shards of the worker are released and a new session is started. Run blocks
+// until the ctx is canceled or an error occurs and returns the error.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Run(
+	ctx context.Context,
+	client coordination.Client,
+	path string,
+	shards []string,
+	handler Handler,
+	opts ...Option,
+) error {
+	c := config{
+		semaphorePrefix: defaultSemaphorePrefix,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&c)
+		}
+	}
+
+	for {
+		w := &worker{
+			config:  c,
+			shards:  shards,
+			handler: handler,
+			active:  make(map[string]*shardWorker),
+			stopped: make(map[string]*shardWorker),
+		}
+		if err := w.run(ctx, client, path); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if ctx.Err() != nil {
+			return xerrors.WithStackTrace(ctx.Err())
+		}
+	}
+}
+
+type worker struct {
+	config  config
+	shards  []string
+	handler Handler
+	session coordination.Session
+
+	wg      sync.WaitGroup
+	active  map[string]*shardWorker
+	stopped map[string]*shardWorker
+}
+
+type shardWorker struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// run processes shards within a single coordination session. It returns nil if the session is lost after the worker
+// joined the members.
+func (w *worker) run(ctx context.Context, client coordination.Client, path string) error {
+	session, err := client.Session(ctx, path, w.config.sessionOptions...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = session.Close(xcontext.ValueOnly(ctx))
+	}()
+	w.session = session
+
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+
+	defer w.wg.Wait()
+	defer w.stopAll()
+
+	membersName := w.config.semaphorePrefix + "members"
+	membership, err := session.AcquireSemaphore(ctx, membersName, coordination.Shared, options.WithEphemeral(true))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Leave the members explicitly to make other workers take the shards without waiting for the session timeout.
+		_ = membership.Release()
+	}()
+
+	members, changes, err := session.WatchSemaphore(ctx, membersName,
+		options.WithDescribeOwners(true),
+		options.WithWatchOwners(true),
+		options.WithWatchData(false),
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		w.rebalance(ctx, members)
+
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-session.Context().Done():
+			return nil
+		case members, ok = <-changes:
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
+
+func (w *worker) rebalance(ctx context.Context, members *coordination.SemaphoreDescription) {
+	memberIDs := make([]uint64, 0, len(members.Owners))
+	for _, owner := range members.Owners {
+		memberIDs = append(memberIDs, owner.SessionID)
+	}
+
+	sessionID := w.session.SessionID()
+	for _, shard := range w.shards {
+		_, active := w.active[shard]
+		switch owner := shardOwner(shard, memberIDs); {
+		case owner == sessionID && !active:
+			w.start(ctx, shard)
+		case owner != sessionID && active:
+			w.stop(shard)
+		}
+	}
+}
+
+func (w *worker) start(ctx context.Context, shard string) {
+	// The shard may be still held by the previous shard worker, wait for it to release the semaphore. Otherwise the
+	// release of the previous lease could release the new one.
+	prev := w.stopped[shard]
+	delete(w.stopped, shard)
+
+	ctx, cancel := xcontext.WithCancel(ctx)
+	sw := &shardWorker{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	w.active[shard] = sw
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer close(sw.done)
+
+		if prev != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-prev.done:
+			}
+		}
+
+		w.process(ctx, shard)
+	}()
+}
+
+func (w *worker) stop(shard string) {
+	sw := w.active[shard]
+	delete(w.active, shard)
+
+	sw.cancel()
+	w.stopped[shard] = sw
+}
+
+func (w *worker) stopAll() {
+	for shard := range w.active {
+		w.stop(shard)
+	}
+}
+
+func (w *worker) process(ctx context.Context, shard string) {
+	lease, err := w.session.AcquireSemaphore(ctx, w.config.semaphorePrefix+"shard/"+shard,
+		coordination.Exclusive,
+		options.WithEphemeral(true),
+	)
+	if err != nil {
+		// The shard was reassigned or the session is lost.
+		return
+	}
+	defer func() {
+		_ = lease.Release()
+	}()
+
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(lease.Context(), cancel)
+	defer stop()
+
+	w.handler(ctx, shard)
+
+	<-ctx.Done()
+}
+
+// shardOwner returns the member with the highest rendezvous hash of the shard and the member id.
+func shardOwner(shard string, members []uint64) (owner uint64) {
+	var maxScore uint64
+	for _, member := range members {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(shard))
+		_, _ = h.Write(binary.LittleEndian.AppendUint64(nil, member))
+		if score := mix(h.Sum64()); owner == 0 || score > maxScore {
+			owner, maxScore = member, score
+		}
+	}
+
+	return owner
+}
+
+// mix is the finalizer of the splitmix64 generator, it improves distribution of the fnv hash bits.
+func mix(x uint64) uint64 {
+	x ^= x >> 30 //nolint:gomnd
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27 //nolint:gomnd
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31 //nolint:gomnd
+
+	return x
+}
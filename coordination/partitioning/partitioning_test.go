@@ -0,0 +1,131 @@
+package partitioning
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
+	coordinationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestShardOwner(t *testing.T) {
+	shards := make([]string, 1000)
+	for i := range shards {
+		shards[i] = fmt.Sprintf("shard-%d", i)
+	}
+
+	counts := make(map[uint64]int)
+	for _, shard := range shards {
+		counts[shardOwner(shard, []uint64{1, 2, 3})]++
+	}
+	require.Len(t, counts, 3)
+	for _, count := range counts {
+		require.Greater(t, count, 250)
+	}
+
+	// a joined member takes shards from other members only
+	for _, shard := range shards {
+		if owner := shardOwner(shard, []uint64{1, 2, 3, 4}); owner != 4 {
+			require.Equal(t, shardOwner(shard, []uint64{1, 2, 3}), owner)
+		}
+	}
+
+	require.Zero(t, shardOwner("shard", nil))
+}
+
+// testAssignments is a set of shards currently processed by workers.
+type testAssignments struct {
+	m      sync.Mutex
+	shards map[string]string
+}
+
+func (a *testAssignments) handler(t testing.TB, workerName string) Handler {
+	return func(ctx context.Context, shard string) {
+		a.m.Lock()
+		if owner, ok := a.shards[shard]; ok {
+			a.m.Unlock()
+			t.Errorf("shard %q is processed by %q and %q at the same time", shard, owner, workerName)
+
+			return
+		}
+		a.shards[shard] = workerName
+		a.m.Unlock()
+
+		<-ctx.Done()
+
+		a.m.Lock()
+		delete(a.shards, shard)
+		a.m.Unlock()
+	}
+}
+
+func (a *testAssignments) counts() map[string]int {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	counts := make(map[string]int)
+	for _, workerName := range a.shards {
+		counts[workerName]++
+	}
+
+	return counts
+}
+
+func TestRun(t *testing.T) {
+	ctx := xtest.Context(t)
+	node := xtest.NewCoordinationNode()
+	shards := make([]string, 20)
+	for i := range shards {
+		shards[i] = fmt.Sprintf("shard-%d", i)
+	}
+	assignments := &testAssignments{shards: make(map[string]string)}
+
+	startWorker := func(name string) (stop func()) {
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func() {
+			done <- Run(ctx, newTestClient(t, node), "/local/node", shards, assignments.handler(t, name))
+		}()
+
+		return func() {
+			cancel()
+			require.ErrorIs(t, <-done, context.Canceled)
+		}
+	}
+
+	stopFirst := startWorker("first")
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return assignments.counts()["first"] == len(shards)
+	})
+
+	stopSecond := startWorker("second")
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		counts := assignments.counts()
+
+		return counts["first"] > 0 && counts["second"] > 0 && counts["first"]+counts["second"] == len(shards)
+	})
+
+	stopFirst()
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return assignments.counts()["second"] == len(shards)
+	})
+
+	stopSecond()
+	require.Empty(t, assignments.counts())
+}
+
+func newTestClient(t testing.TB, node *xtest.CoordinationNode) coordination.Client {
+	ctx := xtest.Context(t)
+	client := internalCoordination.New(ctx, node, coordinationConfig.New())
+	t.Cleanup(func() {
+		_ = client.Close(ctx)
+	})
+
+	return client
+}
@@ -21,6 +21,8 @@ import (
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	internalRatelimiter "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter"
@@ -38,6 +40,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
@@ -80,6 +83,9 @@ type Driver struct {
 	scheme        *xsync.Once[*internalScheme.Client]
 	schemeOptions []schemeConfig.Option
 
+	operation        *xsync.Once[*internalOperation.Client]
+	operationOptions []operationConfig.Option
+
 	coordination        *xsync.Once[*internalCoordination.Client]
 	coordinationOptions []coordinationConfig.Option
 
@@ -147,6 +153,7 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 		d.ratelimiter.Close,
 		d.coordination.Close,
 		d.scheme.Close,
+		d.operation.Close,
 		d.scripting.Close,
 		d.table.Close,
 		d.query.Close,
@@ -201,6 +208,13 @@ func (d *Driver) Scheme() scheme.Client {
 	return d.scheme.Get()
 }
 
+// Operation returns operation client for long-running operations
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Operation() operation.Client {
+	return d.operation.Get()
+}
+
 // Coordination returns coordination client
 func (d *Driver) Coordination() coordination.Client {
 	return d.coordination.Get()
@@ -359,6 +373,7 @@ func newConnectionFromOptions(ctx context.Context, opts ...Option) (_ *Driver, e
 			WithTraceQuery(log.Query(d.logger, d.loggerDetails, d.loggerOpts...)),         //nolint:contextcheck
 			WithTraceScripting(log.Scripting(d.logger, d.loggerDetails, d.loggerOpts...)), //nolint:contextcheck
 			WithTraceScheme(log.Scheme(d.logger, d.loggerDetails, d.loggerOpts...)),
+			WithTraceOperation(log.Operation(d.logger, d.loggerDetails, d.loggerOpts...)),
 			WithTraceCoordination(log.Coordination(d.logger, d.loggerDetails, d.loggerOpts...)),
 			WithTraceRatelimiter(log.Ratelimiter(d.logger, d.loggerDetails, d.loggerOpts...)),
 			WithTraceDiscovery(log.Discovery(d.logger, d.loggerDetails, d.loggerOpts...)),     //nolint:contextcheck
@@ -457,6 +472,21 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 		)
 	})
 
+	d.operation = xsync.OnceValue(func() *internalOperation.Client {
+		return internalOperation.New(
+			d.balancer,
+			operationConfig.New(
+				append(
+					// prepend common params from root config
+					[]operationConfig.Option{
+						operationConfig.With(d.config.Common),
+					},
+					d.operationOptions...,
+				)...,
+			),
+		)
+	})
+
 	d.coordination = xsync.OnceValue(func() *internalCoordination.Client {
 		return internalCoordination.New(xcontext.ValueOnly(ctx),
 			d.balancer,
@@ -6,7 +6,8 @@ require (
 	github.com/golang-jwt/jwt/v4 v4.4.1
 	github.com/google/uuid v1.3.0
 	github.com/jonboulle/clockwork v0.3.0
-	github.com/ydb-platform/ydb-go-genproto v0.0.0-20240316140903-4a47abca1cca
+	github.com/klauspost/compress v1.17.9
+	github.com/ydb-platform/ydb-go-genproto v0.0.0-20260810123728-f0c151ab31b9
 	golang.org/x/net v0.23.0
 	golang.org/x/sync v0.3.0
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
@@ -43,7 +43,7 @@ type Balancer struct {
 	mu               xsync.RWMutex
 	connectionsState *connectionsState
 
-	onApplyDiscoveredEndpoints []func(ctx context.Context, endpoints []endpoint.Info)
+	onApplyDiscoveredEndpoints map[*func(ctx context.Context, endpoints []endpoint.Info)]struct{}
 }
 
 func (b *Balancer) HasNode(id uint32) bool {
@@ -59,10 +59,24 @@ func (b *Balancer) HasNode(id uint32) bool {
 	return false
 }
 
-func (b *Balancer) OnUpdate(onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info)) {
+// OnUpdate registers callback which called on apply discovered endpoints.
+// Returned func unregisters the callback.
+func (b *Balancer) OnUpdate(
+	onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info),
+) (unsubscribe func()) {
+	key := &onApplyDiscoveredEndpoints
 	b.mu.WithLock(func() {
-		b.onApplyDiscoveredEndpoints = append(b.onApplyDiscoveredEndpoints, onApplyDiscoveredEndpoints)
+		if b.onApplyDiscoveredEndpoints == nil {
+			b.onApplyDiscoveredEndpoints = make(map[*func(ctx context.Context, endpoints []endpoint.Info)]struct{})
+		}
+		b.onApplyDiscoveredEndpoints[key] = struct{}{}
 	})
+
+	return func() {
+		b.mu.WithLock(func() {
+			delete(b.onApplyDiscoveredEndpoints, key)
+		})
+	}
 }
 
 func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
@@ -205,8 +219,8 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 			previousConns = b.connectionsState.all
 		}
 		b.connectionsState = state
-		for _, onApplyDiscoveredEndpoints := range b.onApplyDiscoveredEndpoints {
-			onApplyDiscoveredEndpoints(ctx, endpointsInfo)
+		for onApplyDiscoveredEndpoints := range b.onApplyDiscoveredEndpoints {
+			(*onApplyDiscoveredEndpoints)(ctx, endpointsInfo)
 		}
 	})
 }
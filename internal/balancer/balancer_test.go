@@ -1,10 +1,12 @@
 package balancer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
@@ -123,3 +125,31 @@ func TestEndpointsDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestBalancerOnUpdate(t *testing.T) {
+	ctx := xtest.Context(t)
+	cfg := config.New()
+	b := &Balancer{
+		driverConfig: cfg,
+		config:       *cfg.Balancer(),
+		pool:         conn.NewPool(ctx, cfg),
+	}
+	endpoints := []endpoint.Endpoint{&mock.Endpoint{AddrField: "a:123", NodeIDField: 1}}
+
+	var first, second int
+	unsubscribeFirst := b.OnUpdate(func(ctx context.Context, endpoints []endpoint.Info) {
+		first++
+	})
+	b.OnUpdate(func(ctx context.Context, endpoints []endpoint.Info) {
+		second++
+	})
+
+	b.applyDiscoveredEndpoints(ctx, endpoints, "")
+	require.Equal(t, 1, first)
+	require.Equal(t, 1, second)
+
+	unsubscribeFirst()
+	b.applyDiscoveredEndpoints(ctx, endpoints, "")
+	require.Equal(t, 1, first)
+	require.Equal(t, 2, second)
+}
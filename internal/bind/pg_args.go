@@ -0,0 +1,63 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// PgArgs binds args of query with PostgreSQL syntax to $1, $2, ... placeholders.
+// Query keeps as is, args converts to postgres values with parameter names $p1, $p2, ...
+type PgArgs struct{}
+
+func (m PgArgs) blockID() blockID {
+	return blockYQL
+}
+
+func (m PgArgs) RewriteQuery(sql string, args ...interface{}) (yql string, newArgs []interface{}, err error) {
+	newArgs = make([]interface{}, 0, len(args))
+	for i, arg := range args {
+		n, v, err := pgArg(i+1, arg)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+		switch x := v.(type) {
+		case *params.Parameters, *params.Parameter:
+			newArgs = append(newArgs, x)
+		default:
+			p, err := params.PgArg(n, v)
+			if err != nil {
+				return "", nil, xerrors.WithStackTrace(err)
+			}
+			newArgs = append(newArgs, p)
+		}
+	}
+
+	return sql, newArgs, nil
+}
+
+// pgArg unwraps positional arg into number of placeholder and value
+func pgArg(n int, arg interface{}) (int, interface{}, error) {
+	switch x := arg.(type) {
+	case driver.NamedValue:
+		if x.Name != "" {
+			return 0, nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: named arg %q is not supported for PostgreSQL syntax", ErrInconsistentArgs, x.Name),
+			)
+		}
+		if x.Ordinal > 0 {
+			n = x.Ordinal
+		}
+
+		return n, x.Value, nil
+	case sql.NamedArg:
+		return 0, nil, xerrors.WithStackTrace(
+			fmt.Errorf("%w: named arg %q is not supported for PostgreSQL syntax", ErrInconsistentArgs, x.Name),
+		)
+	default:
+		return n, arg, nil
+	}
+}
@@ -0,0 +1,70 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestPgArgsBindRewriteQuery(t *testing.T) {
+	b := PgArgs{}
+	for _, tt := range []struct {
+		name   string
+		sql    string
+		args   []interface{}
+		params []interface{}
+		err    error
+	}{
+		{
+			name: "Values",
+			sql:  `SELECT $1, $2, $3`,
+			args: []interface{}{
+				int32(1),
+				"test",
+				nil,
+			},
+			params: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDInt4, "1")),
+				params.Named("$p2", value.PgValue(pg.OIDText, "test")),
+				params.Named("$p3", value.PgNullValue(pg.OIDUnknown)),
+			},
+		},
+		{
+			name: "NamedValues",
+			sql:  `SELECT $1, $2`,
+			args: []interface{}{
+				driver.NamedValue{Ordinal: 1, Value: int64(1)},
+				driver.NamedValue{Ordinal: 2, Value: value.Int32Value(2)},
+			},
+			params: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDInt8, "1")),
+				params.Named("$p2", value.Int32Value(2)),
+			},
+		},
+		{
+			name: "NamedArg",
+			sql:  `SELECT $1`,
+			args: []interface{}{
+				sql.Named("a", 1),
+			},
+			err: ErrInconsistentArgs,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			yql, params, err := b.RewriteQuery(tt.sql, tt.args...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.sql, yql)
+				require.Equal(t, tt.params, params)
+			}
+		})
+	}
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Coordination_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/conversation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -32,6 +34,14 @@ type session struct {
 	mutex                sync.Mutex // guards the field below
 	lastGoodResponseTime time.Time
 	cancelStream         context.CancelFunc
+	watches              map[*semaphoreWatch]struct{}
+}
+
+// semaphoreWatch is a state of WatchSemaphore call. Server notifies about changes with request id of the last
+// DescribeSemaphore request with watch, the request id is regenerated on every send of the request.
+type semaphoreWatch struct {
+	reqID  uint64 // guarded by the session mutex
+	notify chan struct{}
 }
 
 type lease struct {
@@ -55,6 +65,7 @@ func createSession(
 		cancel:            cancel,
 		sessionClosedChan: make(chan struct{}),
 		controller:        conversation.NewController(),
+		watches:           make(map[*semaphoreWatch]struct{}),
 	}
 	client.sessionCreated(&s)
 
@@ -261,6 +272,9 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 			} else if start.GetSessionId() != s.sessionID {
 				// Reconnect if the server response is invalid.
 				cancelStream()
+			} else {
+				// Changes of watched semaphores may be lost while the session was detached, describe them again.
+				s.notifyWatches()
 			}
 			close(startSending)
 		case <-sessionStartTimer.C:
@@ -428,6 +442,10 @@ func (s *session) receiveLoop(
 			s.updateLastGoodResponseTime()
 		case *Ydb_Coordination.SessionResponse_Pong:
 			// Ignore pongs since we do not ping the server.
+		case *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_:
+			// Notifications of unknown watches are ignored, they are left from previous requests of the watch.
+			s.onSemaphoreChanged(message.GetDescribeSemaphoreChanged().GetReqId())
+			s.updateLastGoodResponseTime()
 		default:
 			if !s.controller.OnRecv(message) {
 				// Reconnect if the message is not from any known conversation.
@@ -711,6 +729,172 @@ func (s *session) DescribeSemaphore(
 	return convertSemaphoreDescription(resp.GetDescribeSemaphoreResult().GetSemaphoreDescription()), nil
 }
 
+func (s *session) WatchSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, <-chan *coordination.SemaphoreDescription, error) {
+	watch := &semaphoreWatch{
+		notify: make(chan struct{}, 1),
+	}
+	s.addWatch(watch)
+
+	desc, err := s.describeSemaphoreWithWatch(ctx, watch, name, opts)
+	if err != nil {
+		s.removeWatch(watch)
+
+		return nil, nil, err
+	}
+
+	changes := make(chan *coordination.SemaphoreDescription)
+	go s.watchSemaphoreLoop(ctx, watch, name, opts, desc, changes)
+
+	return convertSemaphoreDescription(desc), changes, nil
+}
+
+func (s *session) watchSemaphoreLoop(
+	ctx context.Context,
+	watch *semaphoreWatch,
+	name string,
+	opts []options.DescribeSemaphoreOption,
+	last *Ydb_Coordination.SemaphoreDescription,
+	changes chan<- *coordination.SemaphoreDescription,
+) {
+	defer close(changes)
+	defer s.removeWatch(watch)
+
+	var pending *coordination.SemaphoreDescription
+	for {
+		// Send to the channel only if there is a state to send.
+		var out chan<- *coordination.SemaphoreDescription
+		if pending != nil {
+			out = changes
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.ctx.Done():
+			return
+		case out <- pending:
+			pending = nil
+		case <-watch.notify:
+			desc, err := s.describeSemaphoreWithWatch(ctx, watch, name, opts)
+			if err != nil {
+				return
+			}
+			if !proto.Equal(desc, last) {
+				last = desc
+				pending = convertSemaphoreDescription(desc)
+			}
+		}
+	}
+}
+
+func (s *session) describeSemaphoreWithWatch(
+	ctx context.Context,
+	watch *semaphoreWatch,
+	name string,
+	opts []options.DescribeSemaphoreOption,
+) (*Ydb_Coordination.SemaphoreDescription, error) {
+	req := conversation.NewConversation(
+		func() *Ydb_Coordination.SessionRequest {
+			describeSemaphore := Ydb_Coordination.SessionRequest_DescribeSemaphore{
+				ReqId:       newReqID(),
+				Name:        name,
+				WatchData:   true,
+				WatchOwners: true,
+			}
+			for _, o := range opts {
+				if o != nil {
+					o(&describeSemaphore)
+				}
+			}
+			s.setWatchReqID(watch, describeSemaphore.GetReqId())
+
+			return &Ydb_Coordination.SessionRequest{
+				Request: &Ydb_Coordination.SessionRequest_DescribeSemaphore_{
+					DescribeSemaphore: &describeSemaphore,
+				},
+			}
+		},
+		conversation.WithResponseFilter(func(
+			request *Ydb_Coordination.SessionRequest,
+			response *Ydb_Coordination.SessionResponse,
+		) bool {
+			return response.GetDescribeSemaphoreResult().GetReqId() == request.GetDescribeSemaphore().GetReqId()
+		}),
+		conversation.WithConflictKey(name),
+		conversation.WithIdempotence(true),
+	)
+	if err := s.controller.PushBack(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.controller.Await(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.GetDescribeSemaphoreResult()
+	if result.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(
+			xerrors.WithStatusCode(result.GetStatus()),
+			xerrors.WithIssues(result.GetIssues()),
+		))
+	}
+
+	return result.GetSemaphoreDescription(), nil
+}
+
+func (s *session) addWatch(watch *semaphoreWatch) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.watches[watch] = struct{}{}
+}
+
+func (s *session) removeWatch(watch *semaphoreWatch) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.watches, watch)
+}
+
+func (s *session) setWatchReqID(watch *semaphoreWatch, reqID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	watch.reqID = reqID
+}
+
+func (s *session) onSemaphoreChanged(reqID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for watch := range s.watches {
+		if watch.reqID == reqID {
+			watch.signal()
+		}
+	}
+}
+
+func (s *session) notifyWatches() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for watch := range s.watches {
+		watch.signal()
+	}
+}
+
+func (w *semaphoreWatch) signal() {
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
 func convertSemaphoreDescription(
 	desc *Ydb_Coordination.SemaphoreDescription,
 ) *coordination.SemaphoreDescription {
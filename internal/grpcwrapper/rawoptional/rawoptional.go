@@ -35,6 +35,33 @@ func (v *Duration) ToProto() *durationpb.Duration {
 	return nil
 }
 
+func (v *Duration) MustFromProto(proto *durationpb.Duration) {
+	if proto == nil {
+		v.Value = time.Duration(0)
+		v.HasValue = false
+
+		return
+	}
+
+	v.HasValue = true
+	v.Value = proto.AsDuration()
+}
+
+type Int32 struct {
+	Value    int32
+	HasValue bool
+}
+
+func (v *Int32) ToProto() *int32 {
+	if !v.HasValue {
+		return nil
+	}
+
+	val := v.Value
+
+	return &val
+}
+
 type Int64 struct {
 	Value    int64
 	HasValue bool
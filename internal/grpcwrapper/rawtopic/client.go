@@ -29,6 +29,16 @@ func (c *Client) AlterTopic(ctx context.Context, req *AlterTopicRequest) (res Al
 	return res, err
 }
 
+func (c *Client) CommitOffset(ctx context.Context, req *CommitOffsetRequest) (res CommitOffsetResult, err error) {
+	resp, err := c.service.CommitOffset(ctx, req.ToProto())
+	if err != nil {
+		return res, xerrors.WithStackTrace(fmt.Errorf("ydb: commit offset grpc failed: %w", err))
+	}
+	err = res.FromProto(resp)
+
+	return res, err
+}
+
 func (c *Client) CreateTopic(
 	ctx context.Context,
 	req *CreateTopicRequest,
@@ -42,6 +52,21 @@ func (c *Client) CreateTopic(
 	return res, err
 }
 
+func (c *Client) DescribeConsumer(
+	ctx context.Context,
+	req DescribeConsumerRequest,
+) (res DescribeConsumerResult, err error) {
+	resp, err := c.service.DescribeConsumer(ctx, req.ToProto())
+	if err != nil {
+		return DescribeConsumerResult{}, xerrors.WithStackTrace(xerrors.Wrap(
+			fmt.Errorf("ydb: describe consumer grpc failed: %w", err),
+		))
+	}
+	err = res.FromProto(resp)
+
+	return res, err
+}
+
 func (c *Client) DescribeTopic(ctx context.Context, req DescribeTopicRequest) (res DescribeTopicResult, err error) {
 	resp, err := c.service.DescribeTopic(ctx, req.ToProto())
 	if err != nil {
@@ -67,6 +92,19 @@ func (c *Client) DropTopic(
 	return res, err
 }
 
+func (c *Client) UpdateOffsetsInTransaction(
+	ctx context.Context,
+	req *UpdateOffsetsInTransactionRequest,
+) (res UpdateOffsetsInTransactionResult, err error) {
+	resp, err := c.service.UpdateOffsetsInTransaction(ctx, req.ToProto())
+	if err != nil {
+		return res, xerrors.WithStackTrace(fmt.Errorf("ydb: update offsets in transaction grpc failed: %w", err))
+	}
+	err = res.FromProto(resp)
+
+	return res, err
+}
+
 func (c *Client) StreamRead(ctxStreamLifeTime context.Context) (rawtopicreader.StreamReader, error) {
 	protoResp, err := c.service.StreamRead(ctxStreamLifeTime)
 	if err != nil {
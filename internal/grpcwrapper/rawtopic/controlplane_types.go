@@ -7,6 +7,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -47,8 +48,10 @@ const (
 )
 
 type PartitioningSettings struct {
-	MinActivePartitions int64
-	PartitionCountLimit int64
+	MinActivePartitions      int64
+	MaxActivePartitions      int64
+	PartitionCountLimit      int64
+	AutoPartitioningSettings AutoPartitioningSettings
 }
 
 func (s *PartitioningSettings) FromProto(proto *Ydb_Topic.PartitioningSettings) error {
@@ -57,26 +60,160 @@ func (s *PartitioningSettings) FromProto(proto *Ydb_Topic.PartitioningSettings)
 	}
 
 	s.MinActivePartitions = proto.GetMinActivePartitions()
+	s.MaxActivePartitions = proto.GetMaxActivePartitions()
 	s.PartitionCountLimit = proto.GetPartitionCountLimit()
+	s.AutoPartitioningSettings.MustFromProto(proto.GetAutoPartitioningSettings())
 
 	return nil
 }
 
 func (s *PartitioningSettings) ToProto() *Ydb_Topic.PartitioningSettings {
 	return &Ydb_Topic.PartitioningSettings{
-		MinActivePartitions: s.MinActivePartitions,
-		PartitionCountLimit: s.PartitionCountLimit,
+		MinActivePartitions:      s.MinActivePartitions,
+		MaxActivePartitions:      s.MaxActivePartitions,
+		PartitionCountLimit:      s.PartitionCountLimit,
+		AutoPartitioningSettings: s.AutoPartitioningSettings.ToProto(),
+	}
+}
+
+type AutoPartitioningStrategy int32
+
+const (
+	AutoPartitioningStrategyUnspecified = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_UNSPECIFIED,
+	)
+	AutoPartitioningStrategyDisabled = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_DISABLED,
+	)
+	AutoPartitioningStrategyScaleUp = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_SCALE_UP,
+	)
+	AutoPartitioningStrategyScaleUpAndDown = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_SCALE_UP_AND_DOWN,
+	)
+	AutoPartitioningStrategyPaused = AutoPartitioningStrategy(
+		Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_PAUSED,
+	)
+)
+
+type AutoPartitioningSettings struct {
+	AutoPartitioningStrategy           AutoPartitioningStrategy
+	AutoPartitioningWriteSpeedStrategy AutoPartitioningWriteSpeedStrategy
+}
+
+func (s *AutoPartitioningSettings) MustFromProto(proto *Ydb_Topic.AutoPartitioningSettings) {
+	s.AutoPartitioningStrategy = AutoPartitioningStrategy(proto.GetStrategy())
+	s.AutoPartitioningWriteSpeedStrategy.MustFromProto(proto.GetPartitionWriteSpeed())
+}
+
+func (s *AutoPartitioningSettings) ToProto() *Ydb_Topic.AutoPartitioningSettings {
+	// skip unset settings for use default settings of server
+	if *s == (AutoPartitioningSettings{}) {
+		return nil
+	}
+
+	return &Ydb_Topic.AutoPartitioningSettings{
+		Strategy:            Ydb_Topic.AutoPartitioningStrategy(s.AutoPartitioningStrategy),
+		PartitionWriteSpeed: s.AutoPartitioningWriteSpeedStrategy.ToProto(),
+	}
+}
+
+type AutoPartitioningWriteSpeedStrategy struct {
+	StabilizationWindow    rawoptional.Duration
+	UpUtilizationPercent   int32
+	DownUtilizationPercent int32
+}
+
+func (s *AutoPartitioningWriteSpeedStrategy) MustFromProto(proto *Ydb_Topic.AutoPartitioningWriteSpeedStrategy) {
+	s.StabilizationWindow.MustFromProto(proto.GetStabilizationWindow())
+	s.UpUtilizationPercent = proto.GetUpUtilizationPercent()
+	s.DownUtilizationPercent = proto.GetDownUtilizationPercent()
+}
+
+func (s *AutoPartitioningWriteSpeedStrategy) ToProto() *Ydb_Topic.AutoPartitioningWriteSpeedStrategy {
+	if *s == (AutoPartitioningWriteSpeedStrategy{}) {
+		return nil
+	}
+
+	return &Ydb_Topic.AutoPartitioningWriteSpeedStrategy{
+		StabilizationWindow:    s.StabilizationWindow.ToProto(),
+		UpUtilizationPercent:   s.UpUtilizationPercent,
+		DownUtilizationPercent: s.DownUtilizationPercent,
 	}
 }
 
 type AlterPartitioningSettings struct {
-	SetMinActivePartitions rawoptional.Int64
-	SetPartitionCountLimit rawoptional.Int64
+	SetMinActivePartitions        rawoptional.Int64
+	SetMaxActivePartitions        rawoptional.Int64
+	SetPartitionCountLimit        rawoptional.Int64
+	AlterAutoPartitioningSettings *AlterAutoPartitioningSettings
 }
 
 func (s *AlterPartitioningSettings) ToProto() *Ydb_Topic.AlterPartitioningSettings {
-	return &Ydb_Topic.AlterPartitioningSettings{
+	res := &Ydb_Topic.AlterPartitioningSettings{
 		SetMinActivePartitions: s.SetMinActivePartitions.ToProto(),
+		SetMaxActivePartitions: s.SetMaxActivePartitions.ToProto(),
 		SetPartitionCountLimit: s.SetPartitionCountLimit.ToProto(),
 	}
+	if s.AlterAutoPartitioningSettings != nil {
+		res.AlterAutoPartitioningSettings = s.AlterAutoPartitioningSettings.ToProto()
+	}
+
+	return res
+}
+
+type AlterAutoPartitioningSettings struct {
+	SetStrategy               *AutoPartitioningStrategy
+	SetStabilizationWindow    rawoptional.Duration
+	SetUpUtilizationPercent   rawoptional.Int32
+	SetDownUtilizationPercent rawoptional.Int32
+}
+
+func (s *AlterAutoPartitioningSettings) ToProto() *Ydb_Topic.AlterAutoPartitioningSettings {
+	res := &Ydb_Topic.AlterAutoPartitioningSettings{}
+	if s.SetStrategy != nil {
+		strategy := Ydb_Topic.AutoPartitioningStrategy(*s.SetStrategy)
+		res.SetStrategy = &strategy
+	}
+
+	if s.SetStabilizationWindow.HasValue || s.SetUpUtilizationPercent.HasValue || s.SetDownUtilizationPercent.HasValue {
+		res.SetPartitionWriteSpeed = &Ydb_Topic.AlterAutoPartitioningWriteSpeedStrategy{
+			SetStabilizationWindow:    s.SetStabilizationWindow.ToProto(),
+			SetUpUtilizationPercent:   s.SetUpUtilizationPercent.ToProto(),
+			SetDownUtilizationPercent: s.SetDownUtilizationPercent.ToProto(),
+		}
+	}
+
+	return res
+}
+
+type PartitionStats struct {
+	PartitionsOffset rawtopicreader.OffsetRange
+	StoreSizeBytes   int64
+	LastWriteTime    rawoptional.Time
+	MaxWriteTimeLag  rawoptional.Duration
+	BytesWritten     MultipleWindowsStat
+	PartitionNodeID  int32
+}
+
+func (ps *PartitionStats) MustFromProto(proto *Ydb_Topic.PartitionStats) {
+	ps.PartitionsOffset.Start.FromInt64(proto.GetPartitionOffsets().GetStart())
+	ps.PartitionsOffset.End.FromInt64(proto.GetPartitionOffsets().GetEnd())
+	ps.StoreSizeBytes = proto.GetStoreSizeBytes()
+	ps.LastWriteTime.MustFromProto(proto.GetLastWriteTime())
+	ps.MaxWriteTimeLag.MustFromProto(proto.GetMaxWriteTimeLag())
+	ps.BytesWritten.MustFromProto(proto.GetBytesWritten())
+	ps.PartitionNodeID = proto.GetPartitionNodeId()
+}
+
+type MultipleWindowsStat struct {
+	PerMinute int64
+	PerHour   int64
+	PerDay    int64
+}
+
+func (s *MultipleWindowsStat) MustFromProto(proto *Ydb_Topic.MultipleWindowsStat) {
+	s.PerMinute = proto.GetPerMinute()
+	s.PerHour = proto.GetPerHour()
+	s.PerDay = proto.GetPerDay()
 }
@@ -0,0 +1,108 @@
+package rawtopic
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawscheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type DescribeConsumerRequest struct {
+	OperationParams rawydb.OperationParams
+	Path            string
+	Consumer        string
+	IncludeStats    bool
+}
+
+func (req *DescribeConsumerRequest) ToProto() *Ydb_Topic.DescribeConsumerRequest {
+	return &Ydb_Topic.DescribeConsumerRequest{
+		OperationParams: req.OperationParams.ToProto(),
+		Path:            req.Path,
+		Consumer:        req.Consumer,
+		IncludeStats:    req.IncludeStats,
+	}
+}
+
+type DescribeConsumerResult struct {
+	Operation rawydb.Operation
+
+	Self       rawscheme.Entry
+	Consumer   Consumer
+	Partitions []DescribeConsumerResultPartitionInfo
+}
+
+func (res *DescribeConsumerResult) FromProto(protoResponse *Ydb_Topic.DescribeConsumerResponse) error {
+	if err := res.Operation.FromProtoWithStatusCheck(protoResponse.GetOperation()); err != nil {
+		return err
+	}
+
+	protoResult := &Ydb_Topic.DescribeConsumerResult{}
+	if err := protoResponse.GetOperation().GetResult().UnmarshalTo(protoResult); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: describe consumer result failed on unmarshal grpc result: %w", err))
+	}
+
+	if err := res.Self.FromProto(protoResult.GetSelf()); err != nil {
+		return err
+	}
+
+	res.Consumer.MustFromProto(protoResult.GetConsumer())
+
+	protoPartitions := protoResult.GetPartitions()
+	res.Partitions = make([]DescribeConsumerResultPartitionInfo, len(protoPartitions))
+	for i, protoPartition := range protoPartitions {
+		res.Partitions[i].mustFromProto(protoPartition)
+	}
+
+	return nil
+}
+
+type DescribeConsumerResultPartitionInfo struct {
+	PartitionID            int64
+	Active                 bool
+	ChildPartitionIDs      []int64
+	ParentPartitionIDs     []int64
+	PartitionStats         PartitionStats
+	PartitionConsumerStats PartitionConsumerStats
+}
+
+func (pi *DescribeConsumerResultPartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeConsumerResult_PartitionInfo) {
+	pi.PartitionID = proto.GetPartitionId()
+	pi.Active = proto.GetActive()
+
+	pi.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
+	pi.ParentPartitionIDs = clone.Int64Slice(proto.GetParentPartitionIds())
+
+	pi.PartitionStats.MustFromProto(proto.GetPartitionStats())
+	pi.PartitionConsumerStats.mustFromProto(proto.GetPartitionConsumerStats())
+}
+
+type PartitionConsumerStats struct {
+	LastReadOffset                 int64
+	CommittedOffset                int64
+	ReadSessionID                  string
+	PartitionReadSessionCreateTime rawoptional.Time
+	LastReadTime                   rawoptional.Time
+	MaxReadTimeLag                 rawoptional.Duration
+	MaxWriteTimeLag                rawoptional.Duration
+	BytesRead                      MultipleWindowsStat
+	ReaderName                     string
+	ConnectionNodeID               int32
+}
+
+func (s *PartitionConsumerStats) mustFromProto(proto *Ydb_Topic.DescribeConsumerResult_PartitionConsumerStats) {
+	s.LastReadOffset = proto.GetLastReadOffset()
+	s.CommittedOffset = proto.GetCommittedOffset()
+	s.ReadSessionID = proto.GetReadSessionId()
+	s.PartitionReadSessionCreateTime.MustFromProto(proto.GetPartitionReadSessionCreateTime())
+	s.LastReadTime.MustFromProto(proto.GetLastReadTime())
+	s.MaxReadTimeLag.MustFromProto(proto.GetMaxReadTimeLag())
+	s.MaxWriteTimeLag.MustFromProto(proto.GetMaxWriteTimeLag())
+	s.BytesRead.MustFromProto(proto.GetBytesRead())
+	s.ReaderName = proto.GetReaderName()
+	s.ConnectionNodeID = proto.GetConnectionNodeId()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawscheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
@@ -16,12 +17,14 @@ import (
 type DescribeTopicRequest struct {
 	OperationParams rawydb.OperationParams
 	Path            string
+	IncludeStats    bool
 }
 
 func (req *DescribeTopicRequest) ToProto() *Ydb_Topic.DescribeTopicRequest {
 	return &Ydb_Topic.DescribeTopicRequest{
 		OperationParams: req.OperationParams.ToProto(),
 		Path:            req.Path,
+		IncludeStats:    req.IncludeStats,
 	}
 }
 
@@ -39,6 +42,7 @@ type DescribeTopicResult struct {
 	Attributes                        map[string]string
 	Consumers                         []Consumer
 	MeteringMode                      MeteringMode
+	TopicStats                        TopicStats
 }
 
 func (res *DescribeTopicResult) FromProto(protoResponse *Ydb_Topic.DescribeTopicResponse) error {
@@ -83,6 +87,7 @@ func (res *DescribeTopicResult) FromProto(protoResponse *Ydb_Topic.DescribeTopic
 	}
 
 	res.MeteringMode = MeteringMode(protoResult.GetMeteringMode())
+	res.TopicStats.MustFromProto(protoResult.GetTopicStats())
 
 	return nil
 }
@@ -92,6 +97,7 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+	PartitionStats     PartitionStats
 }
 
 func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_PartitionInfo) {
@@ -100,4 +106,19 @@ func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_Part
 
 	pi.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
 	pi.ParentPartitionIDs = clone.Int64Slice(proto.GetParentPartitionIds())
+	pi.PartitionStats.MustFromProto(proto.GetPartitionStats())
+}
+
+type TopicStats struct {
+	StoreSizeBytes   int64
+	MinLastWriteTime rawoptional.Time
+	MaxWriteTimeLag  rawoptional.Duration
+	BytesWritten     MultipleWindowsStat
+}
+
+func (ts *TopicStats) MustFromProto(proto *Ydb_Topic.DescribeTopicResult_TopicStats) {
+	ts.StoreSizeBytes = proto.GetStoreSizeBytes()
+	ts.MinLastWriteTime.MustFromProto(proto.GetMinLastWriteTime())
+	ts.MaxWriteTimeLag.MustFromProto(proto.GetMaxWriteTimeLag())
+	ts.BytesWritten.MustFromProto(proto.GetBytesWritten())
 }
@@ -0,0 +1,20 @@
+package rawtopiccommon
+
+import "github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+// TransactionIdentity identifies query service transaction for topic operations inside the transaction
+type TransactionIdentity struct {
+	ID      string
+	Session string
+}
+
+func (t *TransactionIdentity) ToProto() *Ydb_Topic.TransactionIdentity {
+	if t == nil {
+		return nil
+	}
+
+	return &Ydb_Topic.TransactionIdentity{
+		Id:      t.ID,
+		Session: t.Session,
+	}
+}
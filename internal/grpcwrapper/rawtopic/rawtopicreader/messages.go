@@ -6,6 +6,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -20,6 +21,7 @@ var (
 	errUnexpectedProtoNilStartPartitionSessionRequest = xerrors.Wrap(errors.New("ydb: unexpected proto nil start partition session request"))                      //nolint:lll
 	errUnexpectedNilPartitionSession                  = xerrors.Wrap(errors.New("ydb: unexpected proto nil partition session in start partition session request")) //nolint:lll
 	errUnexpectedGrpcNilStopPartitionSessionRequest   = xerrors.Wrap(errors.New("ydb: unexpected grpc nil stop partition session request"))                        //nolint:lll
+	errUnexpectedGrpcNilEndPartitionSession           = xerrors.Wrap(errors.New("ydb: unexpected grpc nil end partition session"))                                 //nolint:lll
 )
 
 type PartitionSessionID int64
@@ -106,12 +108,14 @@ type InitRequest struct {
 
 	TopicsReadSettings []TopicReadSettings
 
-	Consumer string
+	Consumer                string
+	AutoPartitioningSupport bool
 }
 
 func (r *InitRequest) toProto() *Ydb_Topic.StreamReadMessage_InitRequest {
 	p := &Ydb_Topic.StreamReadMessage_InitRequest{
-		Consumer: r.Consumer,
+		Consumer:                r.Consumer,
+		AutoPartitioningSupport: r.AutoPartitioningSupport,
 	}
 
 	p.TopicsReadSettings = make([]*Ydb_Topic.StreamReadMessage_InitRequest_TopicReadSettings, len(r.TopicsReadSettings))
@@ -503,6 +507,29 @@ func (r *StopPartitionSessionRequest) fromProto(proto *Ydb_Topic.StreamReadMessa
 	return nil
 }
 
+// EndPartitionSession signals that the partition is read to end (it was split or merged).
+// Client must commit all read messages of the partition for allow read of child partitions
+type EndPartitionSession struct {
+	serverMessageImpl
+
+	rawtopiccommon.ServerMessageMetadata
+
+	PartitionSessionID   PartitionSessionID
+	AdjacentPartitionIDs []int64
+	ChildPartitionIDs    []int64
+}
+
+func (r *EndPartitionSession) fromProto(proto *Ydb_Topic.StreamReadMessage_EndPartitionSession) error {
+	if proto == nil {
+		return xerrors.WithStackTrace(errUnexpectedGrpcNilEndPartitionSession)
+	}
+	r.PartitionSessionID.FromInt64(proto.GetPartitionSessionId())
+	r.AdjacentPartitionIDs = clone.Int64Slice(proto.GetAdjacentPartitionIds())
+	r.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
+
+	return nil
+}
+
 type StopPartitionSessionResponse struct {
 	clientMessageImpl
 
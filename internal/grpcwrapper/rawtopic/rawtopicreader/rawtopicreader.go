@@ -47,7 +47,10 @@ func (s StreamReader) Recv() (ServerMessage, error) {
 		return nil, err
 	}
 	if !meta.Status.IsSuccess() {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: bad status from topic server: %v", meta.Status))
+		return nil, xerrors.WithStackTrace(fmt.Errorf(
+			"ydb: bad status from topic server: %w",
+			xerrors.Operation(xerrors.FromOperation(grpcMess)),
+		))
 	}
 
 	switch m := grpcMess.GetServerMessage().(type) {
@@ -80,6 +83,14 @@ func (s StreamReader) Recv() (ServerMessage, error) {
 			return nil, err
 		}
 
+		return req, nil
+	case *Ydb_Topic.StreamReadMessage_FromServer_EndPartitionSession:
+		req := &EndPartitionSession{}
+		req.ServerMessageMetadata = meta
+		if err = req.fromProto(m.EndPartitionSession); err != nil {
+			return nil, err
+		}
+
 		return req, nil
 	case *Ydb_Topic.StreamReadMessage_FromServer_CommitOffsetResponse:
 		resp := &CommitOffsetResponse{}
@@ -56,7 +56,10 @@ func (w *StreamWriter) Recv() (ServerMessage, error) {
 		return nil, err
 	}
 	if !meta.Status.IsSuccess() {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: bad status from topic server: %v", meta.Status))
+		return nil, xerrors.WithStackTrace(fmt.Errorf(
+			"ydb: bad status from topic server: %w",
+			xerrors.Operation(xerrors.FromOperation(grpcMsg)),
+		))
 	}
 
 	switch v := grpcMsg.GetServerMessage().(type) {
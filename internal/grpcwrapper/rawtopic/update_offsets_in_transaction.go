@@ -0,0 +1,68 @@
+package rawtopic
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+)
+
+type UpdateOffsetsInTransactionRequest struct {
+	OperationParams rawydb.OperationParams
+	Tx              rawtopiccommon.TransactionIdentity
+	Topics          []UpdateOffsetsInTransactionRequestTopicOffsets
+	Consumer        string
+}
+
+type UpdateOffsetsInTransactionRequestTopicOffsets struct {
+	Path       string
+	Partitions []UpdateOffsetsInTransactionRequestPartitionOffsets
+}
+
+type UpdateOffsetsInTransactionRequestPartitionOffsets struct {
+	PartitionID      int64
+	PartitionOffsets []rawtopicreader.OffsetRange
+}
+
+func (r *UpdateOffsetsInTransactionRequest) ToProto() *Ydb_Topic.UpdateOffsetsInTransactionRequest {
+	req := &Ydb_Topic.UpdateOffsetsInTransactionRequest{
+		OperationParams: r.OperationParams.ToProto(),
+		Tx:              r.Tx.ToProto(),
+		Topics:          make([]*Ydb_Topic.UpdateOffsetsInTransactionRequest_TopicOffsets, len(r.Topics)),
+		Consumer:        r.Consumer,
+	}
+
+	for topicIndex := range r.Topics {
+		topic := &r.Topics[topicIndex]
+		protoTopic := &Ydb_Topic.UpdateOffsetsInTransactionRequest_TopicOffsets{
+			Path: topic.Path,
+			Partitions: make(
+				[]*Ydb_Topic.UpdateOffsetsInTransactionRequest_TopicOffsets_PartitionOffsets,
+				len(topic.Partitions),
+			),
+		}
+		for partitionIndex := range topic.Partitions {
+			partition := &topic.Partitions[partitionIndex]
+			protoPartition := &Ydb_Topic.UpdateOffsetsInTransactionRequest_TopicOffsets_PartitionOffsets{
+				PartitionId:      partition.PartitionID,
+				PartitionOffsets: make([]*Ydb_Topic.OffsetsRange, len(partition.PartitionOffsets)),
+			}
+			for i := range partition.PartitionOffsets {
+				protoPartition.PartitionOffsets[i] = partition.PartitionOffsets[i].ToProto()
+			}
+			protoTopic.Partitions[partitionIndex] = protoPartition
+		}
+		req.Topics[topicIndex] = protoTopic
+	}
+
+	return req
+}
+
+type UpdateOffsetsInTransactionResult struct {
+	Operation rawydb.Operation
+}
+
+func (r *UpdateOffsetsInTransactionResult) FromProto(proto *Ydb_Topic.UpdateOffsetsInTransactionResponse) error {
+	return r.Operation.FromProtoWithStatusCheck(proto.GetOperation())
+}
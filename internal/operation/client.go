@@ -0,0 +1,263 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var errNilClient = xerrors.Wrap(errors.New("operation client is not initialized"))
+
+// waitBackoff is a backoff between polls of not ready operation in Wait
+var waitBackoff = backoff.New(
+	backoff.WithSlotDuration(100*time.Millisecond), //nolint:gomnd
+	backoff.WithCeiling(6),                         //nolint:gomnd
+)
+
+// Client is a client of operation service for long-running operations
+type Client struct {
+	config  *config.Config
+	service Ydb_Operation_V1.OperationServiceClient
+}
+
+func New(cc grpc.ClientConnInterface, config *config.Config) *Client {
+	return &Client{
+		config:  config,
+		service: Ydb_Operation_V1.NewOperationServiceClient(cc),
+	}
+}
+
+func (c *Client) Close(_ context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return nil
+}
+
+func (c *Client) retry(ctx context.Context, call func(ctx context.Context) error) error {
+	if !c.config.AutoRetry() {
+		return xerrors.WithStackTrace(call(ctx))
+	}
+
+	return retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithIdempotent(true),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+}
+
+// Get returns operation by ID.
+// Not ready operation is not an error, ready operation with non-success status returns with filled Operation.Err()
+func (c *Client) Get(ctx context.Context, opID string) (op *Operation, finalErr error) {
+	onDone := trace.OperationOnGet(c.config.Trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/operation.(*Client).Get"),
+		opID,
+	)
+	defer func() {
+		onDone(op != nil && op.Ready, statusOf(op), finalErr)
+	}()
+	err := c.retry(ctx, func(ctx context.Context) (err error) {
+		op, err = c.get(ctx, opID)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+func (c *Client) get(ctx context.Context, opID string) (*Operation, error) {
+	response, err := c.service.GetOperation(
+		// not ready operation is a normal state for long-running operation
+		conn.WithoutWrapping(ctx),
+		&Ydb_Operations.GetOperationRequest{
+			Id: opID,
+		},
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return FromYDB(response.GetOperation()), nil
+}
+
+// List returns page of operations of given kind
+func (c *Client) List(ctx context.Context, kind string, opts ...ListOption) (res *ListResult, finalErr error) {
+	settings := listSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+	onDone := trace.OperationOnList(c.config.Trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/operation.(*Client).List"),
+		kind, settings.pageSize, settings.pageToken,
+	)
+	defer func() {
+		if res != nil {
+			onDone(len(res.Operations), res.NextToken, finalErr)
+		} else {
+			onDone(0, "", finalErr)
+		}
+	}()
+	err := c.retry(ctx, func(ctx context.Context) (err error) {
+		res, err = c.list(ctx, kind, &settings)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return res, nil
+}
+
+func (c *Client) list(ctx context.Context, kind string, settings *listSettings) (*ListResult, error) {
+	response, err := c.service.ListOperations(ctx, &Ydb_Operations.ListOperationsRequest{
+		Kind:      kind,
+		PageSize:  settings.pageSize,
+		PageToken: settings.pageToken,
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+
+	res := &ListResult{
+		Operations: make([]*Operation, 0, len(response.GetOperations())),
+		NextToken:  response.GetNextPageToken(),
+	}
+	for _, op := range response.GetOperations() {
+		res.Operations = append(res.Operations, FromYDB(op))
+	}
+
+	return res, nil
+}
+
+// Cancel starts cancellation of long-running operation
+func (c *Client) Cancel(ctx context.Context, opID string) (finalErr error) {
+	onDone := trace.OperationOnCancel(c.config.Trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/operation.(*Client).Cancel"),
+		opID,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	return c.retry(ctx, func(ctx context.Context) error {
+		return xerrors.WithStackTrace(c.cancel(ctx, opID))
+	})
+}
+
+func (c *Client) cancel(ctx context.Context, opID string) error {
+	response, err := c.service.CancelOperation(ctx, &Ydb_Operations.CancelOperationRequest{
+		Id: opID,
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+
+	return nil
+}
+
+// Forget forgets long-running operation. Operation becomes unavailable for Get after Forget
+func (c *Client) Forget(ctx context.Context, opID string) (finalErr error) {
+	onDone := trace.OperationOnForget(c.config.Trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/operation.(*Client).Forget"),
+		opID,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	return c.retry(ctx, func(ctx context.Context) error {
+		return xerrors.WithStackTrace(c.forget(ctx, opID))
+	})
+}
+
+func (c *Client) forget(ctx context.Context, opID string) error {
+	response, err := c.service.ForgetOperation(ctx, &Ydb_Operations.ForgetOperationRequest{
+		Id: opID,
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+
+	return nil
+}
+
+// Wait polls operation with backoff until operation becomes ready or context is done.
+// Ready operation with non-success status returns as error
+func (c *Client) Wait(ctx context.Context, opID string) (_ *Operation, finalErr error) {
+	var (
+		attempts int
+		status   string
+	)
+	onDone := trace.OperationOnWait(c.config.Trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/operation.(*Client).Wait"),
+		opID,
+	)
+	defer func() {
+		onDone(attempts, status, finalErr)
+	}()
+	for {
+		attempts++
+		op, err := c.Get(ctx, opID)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		status = op.Status
+		if op.Ready {
+			if err = op.Err(); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return op, nil
+		}
+
+		delay := time.NewTimer(waitBackoff.Delay(attempts - 1))
+		select {
+		case <-ctx.Done():
+			delay.Stop()
+
+			return nil, xerrors.WithStackTrace(ctx.Err())
+		case <-delay.C:
+		}
+	}
+}
+
+func statusOf(op *Operation) string {
+	if op == nil {
+		return ""
+	}
+
+	return op.Status
+}
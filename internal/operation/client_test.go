@@ -0,0 +1,208 @@
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testOperationService struct {
+	Ydb_Operation_V1.OperationServiceClient
+
+	operations []*Ydb_Operations.Operation
+	getCalls   int
+	listCalls  []*Ydb_Operations.ListOperationsRequest
+	cancelled  []string
+	forgotten  []string
+}
+
+func (s *testOperationService) GetOperation(
+	_ context.Context, in *Ydb_Operations.GetOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	op := s.operations[s.getCalls]
+	if s.getCalls < len(s.operations)-1 {
+		s.getCalls++
+	}
+
+	return &Ydb_Operations.GetOperationResponse{
+		Operation: op,
+	}, nil
+}
+
+func (s *testOperationService) ListOperations(
+	_ context.Context, in *Ydb_Operations.ListOperationsRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.ListOperationsResponse, error) {
+	s.listCalls = append(s.listCalls, in)
+	if in.GetPageToken() == "" {
+		return &Ydb_Operations.ListOperationsResponse{
+			Status:        Ydb.StatusIds_SUCCESS,
+			Operations:    s.operations[:1],
+			NextPageToken: "next",
+		}, nil
+	}
+
+	return &Ydb_Operations.ListOperationsResponse{
+		Status:     Ydb.StatusIds_SUCCESS,
+		Operations: s.operations[1:],
+	}, nil
+}
+
+func (s *testOperationService) CancelOperation(
+	_ context.Context, in *Ydb_Operations.CancelOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.CancelOperationResponse, error) {
+	s.cancelled = append(s.cancelled, in.GetId())
+
+	return &Ydb_Operations.CancelOperationResponse{
+		Status: Ydb.StatusIds_SUCCESS,
+	}, nil
+}
+
+func (s *testOperationService) ForgetOperation(
+	_ context.Context, in *Ydb_Operations.ForgetOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.ForgetOperationResponse, error) {
+	s.forgotten = append(s.forgotten, in.GetId())
+
+	return &Ydb_Operations.ForgetOperationResponse{
+		Status: Ydb.StatusIds_NOT_FOUND,
+		Issues: []*Ydb_Issue.IssueMessage{{
+			Message: "operation not found",
+		}},
+	}, nil
+}
+
+func testClient(service *testOperationService, opts ...config.Option) *Client {
+	return &Client{
+		config:  config.New(opts...),
+		service: service,
+	}
+}
+
+func TestClientGet(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("NotReady", func(t *testing.T) {
+		c := testClient(&testOperationService{
+			operations: []*Ydb_Operations.Operation{{
+				Id:     "1",
+				Ready:  false,
+				Status: Ydb.StatusIds_SUCCESS,
+			}},
+		})
+		op, err := c.Get(ctx, "1")
+		require.NoError(t, err)
+		require.Equal(t, "1", op.ID)
+		require.False(t, op.Ready)
+		require.NoError(t, op.Err())
+	})
+	t.Run("ReadyFailed", func(t *testing.T) {
+		c := testClient(&testOperationService{
+			operations: []*Ydb_Operations.Operation{{
+				Id:     "1",
+				Ready:  true,
+				Status: Ydb.StatusIds_GENERIC_ERROR,
+			}},
+		})
+		op, err := c.Get(ctx, "1")
+		require.NoError(t, err)
+		require.True(t, op.Ready)
+		require.Equal(t, "GENERIC_ERROR", op.Status)
+		require.True(t, xerrors.IsOperationError(op.Err(), Ydb.StatusIds_GENERIC_ERROR))
+	})
+}
+
+func TestClientList(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testOperationService{
+		operations: []*Ydb_Operations.Operation{
+			{Id: "1", Ready: true, Status: Ydb.StatusIds_SUCCESS},
+			{Id: "2", Ready: false, Status: Ydb.StatusIds_SUCCESS},
+		},
+	}
+	c := testClient(service)
+	res, err := c.List(ctx, "buildindex", WithPageSize(1))
+	require.NoError(t, err)
+	require.Len(t, res.Operations, 1)
+	require.Equal(t, "1", res.Operations[0].ID)
+	require.Equal(t, "next", res.NextToken)
+	res, err = c.List(ctx, "buildindex", WithPageSize(1), WithPageToken(res.NextToken))
+	require.NoError(t, err)
+	require.Len(t, res.Operations, 1)
+	require.Equal(t, "2", res.Operations[0].ID)
+	require.Empty(t, res.NextToken)
+	require.Len(t, service.listCalls, 2)
+	require.Equal(t, "buildindex", service.listCalls[1].GetKind())
+	require.Equal(t, uint64(1), service.listCalls[1].GetPageSize())
+	require.Equal(t, "next", service.listCalls[1].GetPageToken())
+}
+
+func TestClientCancelForget(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &testOperationService{}
+	c := testClient(service)
+	require.NoError(t, c.Cancel(ctx, "1"))
+	require.Equal(t, []string{"1"}, service.cancelled)
+	err := c.Forget(ctx, "1")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+	require.Equal(t, []string{"1"}, service.forgotten)
+}
+
+func TestClientWait(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("Ready", func(t *testing.T) {
+		var (
+			attempts int
+			status   string
+		)
+		c := testClient(&testOperationService{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "1", Ready: false, Status: Ydb.StatusIds_SUCCESS},
+				{Id: "1", Ready: false, Status: Ydb.StatusIds_SUCCESS},
+				{Id: "1", Ready: true, Status: Ydb.StatusIds_SUCCESS},
+			},
+		}, config.WithTrace(trace.Operation{
+			OnWait: func(trace.OperationWaitStartInfo) func(trace.OperationWaitDoneInfo) {
+				return func(info trace.OperationWaitDoneInfo) {
+					attempts, status = info.Attempts, info.Status
+				}
+			},
+		}))
+		op, err := c.Wait(ctx, "1")
+		require.NoError(t, err)
+		require.True(t, op.Ready)
+		require.Equal(t, 3, attempts)
+		require.Equal(t, "SUCCESS", status)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		c := testClient(&testOperationService{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "1", Ready: false, Status: Ydb.StatusIds_SUCCESS},
+				{Id: "1", Ready: true, Status: Ydb.StatusIds_CANCELLED},
+			},
+		})
+		op, err := c.Wait(ctx, "1")
+		require.Nil(t, op)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_CANCELLED))
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		c := testClient(&testOperationService{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "1", Ready: false, Status: Ydb.StatusIds_SUCCESS},
+			},
+		})
+		_, err := c.Wait(ctx, "1")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
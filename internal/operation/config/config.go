@@ -0,0 +1,47 @@
+package config
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Config is a configuration of operation client
+type Config struct {
+	config.Common
+
+	trace *trace.Operation
+}
+
+// Trace returns trace over operation client calls
+func (c *Config) Trace() *trace.Operation {
+	return c.trace
+}
+
+type Option func(c *Config)
+
+// WithTrace appends operation trace to early defined traces
+func WithTrace(trace trace.Operation, opts ...trace.OperationComposeOption) Option {
+	return func(c *Config) {
+		c.trace = c.trace.Compose(&trace, opts...)
+	}
+}
+
+// With applies common configuration params
+func With(config config.Common) Option {
+	return func(c *Config) {
+		c.Common = config
+	}
+}
+
+func New(opts ...Option) *Config {
+	c := &Config{
+		trace: &trace.Operation{},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
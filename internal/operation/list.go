@@ -0,0 +1,25 @@
+package operation
+
+type (
+	listSettings struct {
+		pageSize  uint64
+		pageToken string
+	}
+
+	// ListOption specified settings of List request
+	ListOption func(s *listSettings)
+)
+
+// WithPageSize sets max count of operations in one page
+func WithPageSize(pageSize uint64) ListOption {
+	return func(s *listSettings) {
+		s.pageSize = pageSize
+	}
+}
+
+// WithPageToken sets token of requested page from ListResult.NextToken of previous page
+func WithPageToken(pageToken string) ListOption {
+	return func(s *listSettings) {
+		s.pageToken = pageToken
+	}
+}
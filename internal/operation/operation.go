@@ -0,0 +1,53 @@
+package operation
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// Operation is a state of long-running operation
+	Operation struct {
+		ID            string
+		Ready         bool
+		Status        string
+		ConsumedUnits float64
+
+		// Metadata is a kind-specific metadata of operation (for example Ydb_Table.IndexBuildMetadata)
+		Metadata *anypb.Any
+		// Result is a kind-specific result of ready operation
+		Result *anypb.Any
+
+		err error
+	}
+
+	// ListResult is a page of operations with token of next page
+	ListResult struct {
+		Operations []*Operation
+		NextToken  string
+	}
+)
+
+// Err returns error of ready operation with non-success status
+func (op *Operation) Err() error {
+	return op.err
+}
+
+func FromYDB(op *Ydb_Operations.Operation) *Operation {
+	operation := &Operation{
+		ID:            op.GetId(),
+		Ready:         op.GetReady(),
+		Status:        op.GetStatus().String(),
+		ConsumedUnits: op.GetCostInfo().GetConsumedUnits(),
+		Metadata:      op.GetMetadata(),
+		Result:        op.GetResult(),
+	}
+	if op.GetReady() && op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		operation.err = xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(op)))
+	}
+
+	return operation
+}
@@ -1,12 +1,53 @@
 package params
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
+// PgArgName returns name of parameter for $n placeholder of query with PostgreSQL syntax
+func PgArgName(n int) string {
+	return fmt.Sprintf("$p%d", n)
+}
+
+// PgArgs makes parameters for $1, $2, ... placeholders of query with PostgreSQL syntax from go values.
+// Go values converts to postgres values (int32 as int4, string as text, time.Time as timestamptz, etc.),
+// values which already are YDB values passes as is
+func PgArgs(args ...interface{}) (Parameters, error) {
+	parameters := make(Parameters, 0, len(args))
+	for i, arg := range args {
+		p, err := PgArg(i+1, arg)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		parameters = append(parameters, p)
+	}
+
+	return parameters, nil
+}
+
+// PgArg makes parameter for $n placeholder of query with PostgreSQL syntax from go value
+func PgArg(n int, arg interface{}) (*Parameter, error) {
+	v, ok := arg.(value.Value)
+	if !ok {
+		var err error
+		v, err = value.PgValueFromGo(arg)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("arg $%d: %w", n, err))
+		}
+	}
+
+	return Named(PgArgName(n), v), nil
+}
+
 type pgParam struct {
 	param *Parameter
 }
@@ -22,6 +63,22 @@ func (p pgParam) Value(oid uint32, val string) Builder {
 	return p.param.parent
 }
 
+// Null makes NULL value of postgres type with given oid
+func (p pgParam) Null(oid uint32) Builder {
+	p.param.value = value.PgNullValue(oid)
+	p.param.parent.params = append(p.param.parent.params, p.param)
+
+	return p.param.parent
+}
+
+func (p pgParam) Bool(val bool) Builder {
+	return p.Value(pg.OIDBool, strconv.FormatBool(val))
+}
+
+func (p pgParam) Int2(val int16) Builder {
+	return p.Value(pg.OIDInt2, strconv.FormatInt(int64(val), 10))
+}
+
 func (p pgParam) Int4(val int32) Builder {
 	return p.Value(pg.OIDInt4, strconv.FormatInt(int64(val), 10))
 }
@@ -29,3 +86,48 @@ func (p pgParam) Int4(val int32) Builder {
 func (p pgParam) Int8(val int64) Builder {
 	return p.Value(pg.OIDInt8, strconv.FormatInt(val, 10))
 }
+
+func (p pgParam) Float4(val float32) Builder {
+	return p.Value(pg.OIDFloat4, strconv.FormatFloat(float64(val), 'g', -1, 32))
+}
+
+func (p pgParam) Float8(val float64) Builder {
+	return p.Value(pg.OIDFloat8, strconv.FormatFloat(val, 'g', -1, 64))
+}
+
+// Numeric makes numeric value from text representation of number (such as "123.45")
+func (p pgParam) Numeric(val string) Builder {
+	return p.Value(pg.OIDNumeric, val)
+}
+
+func (p pgParam) Text(val string) Builder {
+	return p.Value(pg.OIDText, val)
+}
+
+func (p pgParam) Bytea(val []byte) Builder {
+	return p.Value(pg.OIDBytea, `\x`+hex.EncodeToString(val))
+}
+
+func (p pgParam) JSON(val string) Builder {
+	return p.Value(pg.OIDJSON, val)
+}
+
+func (p pgParam) JSONB(val string) Builder {
+	return p.Value(pg.OIDJSONB, val)
+}
+
+func (p pgParam) UUID(val uuid.UUID) Builder {
+	return p.Value(pg.OIDUUID, val.String())
+}
+
+func (p pgParam) Date(val time.Time) Builder {
+	return p.Value(pg.OIDDate, val.Format(pg.DateLayout))
+}
+
+func (p pgParam) Timestamp(val time.Time) Builder {
+	return p.Value(pg.OIDTimestamp, val.Format(pg.TimestampLayout))
+}
+
+func (p pgParam) Timestamptz(val time.Time) Builder {
+	return p.Value(pg.OIDTimestamptz, val.Format(pg.TimestamptzLayout))
+}
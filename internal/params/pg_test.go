@@ -2,6 +2,7 @@ package params
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -74,6 +75,74 @@ func TestPg(t *testing.T) {
 				},
 			},
 		},
+		{
+			method: "Text",
+			args:   []any{"test"},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDText,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "test"},
+				},
+			},
+		},
+		{
+			method: "Bytea",
+			args:   []any{[]byte{0x01, 0xab}},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDBytea,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: `\x01ab`},
+				},
+			},
+		},
+		{
+			method: "Timestamptz",
+			args:   []any{time.Date(2024, 1, 2, 3, 4, 5, 6000, time.FixedZone("", 3*60*60))},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDTimestamptz,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "2024-01-02 03:04:05.000006+03:00"},
+				},
+			},
+		},
+		{
+			method: "Null",
+			args:   []any{uint32(pg.OIDInt4)},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDInt4,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_NullFlagValue{},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,245 @@
+package pg
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	errNullValue              = errors.New("cannot scan NULL postgres value into not nullable destination")
+	errUnsupportedDestination = errors.New("unsupported destination for postgres value")
+)
+
+// timestamptzLayouts are layouts of timestamptz text output with different time zone offsets
+var timestamptzLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+}
+
+// Decode converts postgres value into go destination.
+// Destination must be a pointer. Pointer to pointer destination used for scan nullable values
+//
+//nolint:gocyclo,funlen
+func Decode(v Value, dst interface{}) (err error) {
+	if rv := reflect.ValueOf(dst); rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Ptr {
+		return decodeNullable(v, rv)
+	}
+
+	if vv, ok := dst.(*interface{}); ok {
+		if v.Null {
+			*vv = nil
+
+			return nil
+		}
+		*vv, err = Native(v)
+
+		return err
+	}
+
+	if v.Null {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T (oid=%d)", errNullValue, dst, v.OID))
+	}
+
+	switch vv := dst.(type) {
+	case *Value:
+		*vv = v
+	case *string:
+		*vv = v.Text
+	case *[]byte:
+		*vv, err = decodeBytes(v)
+	case *json.RawMessage:
+		*vv = json.RawMessage(v.Text)
+	case *bool:
+		*vv, err = decodeBool(v.Text)
+	case *int8:
+		var x int64
+		x, err = strconv.ParseInt(v.Text, 10, 8)
+		*vv = int8(x)
+	case *int16:
+		var x int64
+		x, err = strconv.ParseInt(v.Text, 10, 16)
+		*vv = int16(x)
+	case *int32:
+		var x int64
+		x, err = strconv.ParseInt(v.Text, 10, 32)
+		*vv = int32(x)
+	case *int64:
+		*vv, err = strconv.ParseInt(v.Text, 10, 64)
+	case *int:
+		var x int64
+		x, err = strconv.ParseInt(v.Text, 10, strconv.IntSize)
+		*vv = int(x)
+	case *uint8:
+		var x uint64
+		x, err = strconv.ParseUint(v.Text, 10, 8)
+		*vv = uint8(x)
+	case *uint16:
+		var x uint64
+		x, err = strconv.ParseUint(v.Text, 10, 16)
+		*vv = uint16(x)
+	case *uint32:
+		var x uint64
+		x, err = strconv.ParseUint(v.Text, 10, 32)
+		*vv = uint32(x)
+	case *uint64:
+		*vv, err = strconv.ParseUint(v.Text, 10, 64)
+	case *uint:
+		var x uint64
+		x, err = strconv.ParseUint(v.Text, 10, strconv.IntSize)
+		*vv = uint(x)
+	case *float32:
+		var x float64
+		x, err = strconv.ParseFloat(v.Text, 32)
+		*vv = float32(x)
+	case *float64:
+		*vv, err = strconv.ParseFloat(v.Text, 64)
+	case *big.Int:
+		if _, ok := vv.SetString(v.Text, 10); !ok {
+			err = fmt.Errorf("cannot parse %q as integer", v.Text)
+		}
+	case *big.Float:
+		_, _, err = vv.Parse(v.Text, 10)
+	case *uuid.UUID:
+		*vv, err = uuid.Parse(v.Text)
+	case *[16]byte:
+		var x uuid.UUID
+		x, err = uuid.Parse(v.Text)
+		*vv = x
+	case *time.Time:
+		*vv, err = decodeTime(v)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T (oid=%d)", errUnsupportedDestination, dst, v.OID))
+	}
+
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot scan postgres value (oid=%d) into %T: %w", v.OID, dst, err))
+	}
+
+	return nil
+}
+
+func decodeNullable(v Value, rv reflect.Value) error {
+	if v.Null {
+		rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+
+		return nil
+	}
+
+	ptr := reflect.New(rv.Elem().Type().Elem())
+	if err := Decode(v, ptr.Interface()); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	rv.Elem().Set(ptr)
+
+	return nil
+}
+
+// Native converts postgres value into go value of type which corresponds to postgres type.
+// Values of unknown types returns as text
+func Native(v Value) (interface{}, error) {
+	if v.Null {
+		return nil, nil //nolint:nilnil
+	}
+
+	var (
+		dst interface{}
+		err error
+	)
+	switch v.OID {
+	case OIDBool:
+		var x bool
+		err = Decode(v, &x)
+		dst = x
+	case OIDInt2:
+		var x int16
+		err = Decode(v, &x)
+		dst = x
+	case OIDInt4:
+		var x int32
+		err = Decode(v, &x)
+		dst = x
+	case OIDInt8:
+		var x int64
+		err = Decode(v, &x)
+		dst = x
+	case OIDFloat4:
+		var x float32
+		err = Decode(v, &x)
+		dst = x
+	case OIDFloat8:
+		var x float64
+		err = Decode(v, &x)
+		dst = x
+	case OIDBytea:
+		var x []byte
+		err = Decode(v, &x)
+		dst = x
+	case OIDDate, OIDTimestamp, OIDTimestamptz:
+		var x time.Time
+		err = Decode(v, &x)
+		dst = x
+	default:
+		dst = v.Text
+	}
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return dst, nil
+}
+
+func decodeBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "t", "true", "y", "yes", "on", "1":
+		return true, nil
+	case "f", "false", "n", "no", "off", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("cannot parse %q as bool", s)
+	}
+}
+
+func decodeBytes(v Value) ([]byte, error) {
+	if v.OID != OIDBytea {
+		return []byte(v.Text), nil
+	}
+
+	if !strings.HasPrefix(v.Text, `\x`) {
+		return nil, fmt.Errorf("unsupported bytea output format of %q (expected hex format)", v.Text)
+	}
+
+	return hex.DecodeString(v.Text[2:])
+}
+
+func decodeTime(v Value) (time.Time, error) {
+	switch v.OID {
+	case OIDDate:
+		return time.ParseInLocation(DateLayout, v.Text, time.UTC)
+	case OIDTimestamp:
+		return time.ParseInLocation(TimestampLayout, v.Text, time.UTC)
+	case OIDTimestamptz:
+		var err error
+		for _, layout := range timestamptzLayouts {
+			var t time.Time
+			if t, err = time.Parse(layout, v.Text); err == nil {
+				return t, nil
+			}
+		}
+
+		return time.Time{}, err
+	default:
+		return time.Time{}, fmt.Errorf("postgres type with oid=%d is not a time type", v.OID)
+	}
+}
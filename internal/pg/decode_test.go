@@ -0,0 +1,80 @@
+package pg
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("Int4", func(t *testing.T) {
+		var dst int32
+		require.NoError(t, Decode(Value{OID: OIDInt4, Text: "123"}, &dst))
+		require.Equal(t, int32(123), dst)
+	})
+	t.Run("Bool", func(t *testing.T) {
+		var dst bool
+		require.NoError(t, Decode(Value{OID: OIDBool, Text: "t"}, &dst))
+		require.True(t, dst)
+	})
+	t.Run("Text", func(t *testing.T) {
+		var dst string
+		require.NoError(t, Decode(Value{OID: OIDText, Text: "test"}, &dst))
+		require.Equal(t, "test", dst)
+	})
+	t.Run("Bytea", func(t *testing.T) {
+		var dst []byte
+		require.NoError(t, Decode(Value{OID: OIDBytea, Text: `\x01ab`}, &dst))
+		require.Equal(t, []byte{0x01, 0xab}, dst)
+	})
+	t.Run("Numeric", func(t *testing.T) {
+		var dst float64
+		require.NoError(t, Decode(Value{OID: OIDNumeric, Text: "1.25"}, &dst))
+		require.Equal(t, 1.25, dst)
+	})
+	t.Run("JSONB", func(t *testing.T) {
+		var dst json.RawMessage
+		require.NoError(t, Decode(Value{OID: OIDJSONB, Text: `{"a": 1}`}, &dst))
+		require.JSONEq(t, `{"a":1}`, string(dst))
+	})
+	t.Run("Timestamptz", func(t *testing.T) {
+		for _, text := range []string{
+			"2024-01-02 06:04:05.123456+03",
+			"2024-01-02 06:04:05.123456+03:00",
+			"2024-01-02 03:04:05.123456Z",
+		} {
+			var dst time.Time
+			require.NoError(t, Decode(Value{OID: OIDTimestamptz, Text: text}, &dst))
+			require.True(t, time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC).Equal(dst), dst)
+		}
+	})
+	t.Run("Date", func(t *testing.T) {
+		var dst time.Time
+		require.NoError(t, Decode(Value{OID: OIDDate, Text: "2024-01-02"}, &dst))
+		require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), dst)
+	})
+	t.Run("Nullable", func(t *testing.T) {
+		dst := new(int64)
+		require.NoError(t, Decode(Value{OID: OIDInt8, Null: true}, &dst))
+		require.Nil(t, dst)
+		require.NoError(t, Decode(Value{OID: OIDInt8, Text: "1"}, &dst))
+		require.Equal(t, int64(1), *dst)
+	})
+	t.Run("NullIntoNotNullable", func(t *testing.T) {
+		var dst int64
+		require.ErrorIs(t, Decode(Value{OID: OIDInt8, Null: true}, &dst), errNullValue)
+	})
+	t.Run("Interface", func(t *testing.T) {
+		var dst interface{}
+		require.NoError(t, Decode(Value{OID: OIDInt2, Text: "1"}, &dst))
+		require.Equal(t, int16(1), dst)
+		require.NoError(t, Decode(Value{OID: OIDInt2, Null: true}, &dst))
+		require.Nil(t, dst)
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		var dst struct{}
+		require.ErrorIs(t, Decode(Value{OID: OIDInt2, Text: "1"}, &dst), errUnsupportedDestination)
+	})
+}
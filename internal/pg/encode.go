@@ -0,0 +1,128 @@
+package pg
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errUnsupportedType = errors.New("unsupported type for postgres value")
+
+// Layouts of text representation of postgres date and time values
+const (
+	DateLayout        = "2006-01-02"
+	TimestampLayout   = "2006-01-02 15:04:05.999999999"
+	TimestamptzLayout = "2006-01-02 15:04:05.999999999Z07:00"
+)
+
+// Value is a text representation of postgres value with oid of postgres type
+type Value struct {
+	OID  uint32
+	Text string
+	Null bool
+}
+
+// Encode converts go value to postgres value.
+// Nil pointers converts to NULL of postgres type which corresponds to type of pointer
+//
+//nolint:gocyclo,funlen
+func Encode(v interface{}) (Value, error) {
+	switch x := v.(type) {
+	case nil:
+		return Value{OID: OIDUnknown, Null: true}, nil
+	case Value:
+		return x, nil
+	case bool:
+		return Value{OID: OIDBool, Text: strconv.FormatBool(x)}, nil
+	case int8:
+		return Value{OID: OIDInt2, Text: strconv.FormatInt(int64(x), 10)}, nil
+	case int16:
+		return Value{OID: OIDInt2, Text: strconv.FormatInt(int64(x), 10)}, nil
+	case uint8:
+		return Value{OID: OIDInt2, Text: strconv.FormatUint(uint64(x), 10)}, nil
+	case int32:
+		return Value{OID: OIDInt4, Text: strconv.FormatInt(int64(x), 10)}, nil
+	case uint16:
+		return Value{OID: OIDInt4, Text: strconv.FormatUint(uint64(x), 10)}, nil
+	case int:
+		return Value{OID: OIDInt8, Text: strconv.FormatInt(int64(x), 10)}, nil
+	case int64:
+		return Value{OID: OIDInt8, Text: strconv.FormatInt(x, 10)}, nil
+	case uint32:
+		return Value{OID: OIDInt8, Text: strconv.FormatUint(uint64(x), 10)}, nil
+	case uint:
+		return Value{OID: OIDNumeric, Text: strconv.FormatUint(uint64(x), 10)}, nil
+	case uint64:
+		return Value{OID: OIDNumeric, Text: strconv.FormatUint(x, 10)}, nil
+	case float32:
+		return Value{OID: OIDFloat4, Text: strconv.FormatFloat(float64(x), 'g', -1, 32)}, nil
+	case float64:
+		return Value{OID: OIDFloat8, Text: strconv.FormatFloat(x, 'g', -1, 64)}, nil
+	case string:
+		return Value{OID: OIDText, Text: x}, nil
+	case []byte:
+		return Value{OID: OIDBytea, Text: `\x` + hex.EncodeToString(x)}, nil
+	case json.RawMessage:
+		return Value{OID: OIDJSONB, Text: string(x)}, nil
+	case *big.Int:
+		if x == nil {
+			return Value{OID: OIDNumeric, Null: true}, nil
+		}
+
+		return Value{OID: OIDNumeric, Text: x.String()}, nil
+	case *big.Float:
+		if x == nil {
+			return Value{OID: OIDNumeric, Null: true}, nil
+		}
+
+		return Value{OID: OIDNumeric, Text: x.Text('f', -1)}, nil
+	case uuid.UUID:
+		return Value{OID: OIDUUID, Text: x.String()}, nil
+	case [16]byte:
+		return Value{OID: OIDUUID, Text: uuid.UUID(x).String()}, nil
+	case time.Time:
+		return Value{OID: OIDTimestamptz, Text: x.Format(TimestamptzLayout)}, nil
+	case driver.Valuer:
+		if rv := reflect.ValueOf(x); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return encodePointer(v)
+		}
+		vv, err := x.Value()
+		if err != nil {
+			return Value{}, xerrors.WithStackTrace(fmt.Errorf("ydb: driver.Valuer error: %w", err))
+		}
+
+		return Encode(vv)
+	default:
+		return encodePointer(v)
+	}
+}
+
+// encodePointer converts pointer to go value into postgres value
+func encodePointer(v interface{}) (Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return Value{}, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errUnsupportedType, v))
+	}
+
+	if !rv.IsNil() {
+		return Encode(rv.Elem().Interface())
+	}
+
+	// NULL value must have a type, so type of value detects by zero value of pointer element type
+	vv, err := Encode(reflect.Zero(rv.Type().Elem()).Interface())
+	if err != nil {
+		return Value{}, xerrors.WithStackTrace(err)
+	}
+
+	return Value{OID: vv.OID, Null: true}, nil
+}
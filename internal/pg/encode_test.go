@@ -0,0 +1,58 @@
+package pg
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	var (
+		nilInt32 *int32
+		text     = "test"
+	)
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+		dst  Value
+	}{
+		{name: "nil", src: nil, dst: Value{OID: OIDUnknown, Null: true}},
+		{name: "bool", src: true, dst: Value{OID: OIDBool, Text: "true"}},
+		{name: "int16", src: int16(-1), dst: Value{OID: OIDInt2, Text: "-1"}},
+		{name: "int32", src: int32(123), dst: Value{OID: OIDInt4, Text: "123"}},
+		{name: "int", src: 123, dst: Value{OID: OIDInt8, Text: "123"}},
+		{name: "uint64", src: uint64(123), dst: Value{OID: OIDNumeric, Text: "123"}},
+		{name: "float64", src: 1.5, dst: Value{OID: OIDFloat8, Text: "1.5"}},
+		{name: "string", src: "test", dst: Value{OID: OIDText, Text: "test"}},
+		{name: "*string", src: &text, dst: Value{OID: OIDText, Text: "test"}},
+		{name: "nil *int32", src: nilInt32, dst: Value{OID: OIDInt4, Null: true}},
+		{name: "nil *uuid.UUID", src: (*uuid.UUID)(nil), dst: Value{OID: OIDUUID, Null: true}},
+		{name: "[]byte", src: []byte{0x01, 0xab}, dst: Value{OID: OIDBytea, Text: `\x01ab`}},
+		{name: "json.RawMessage", src: json.RawMessage(`{"a":1}`), dst: Value{OID: OIDJSONB, Text: `{"a":1}`}},
+		{name: "*big.Float", src: big.NewFloat(1.25), dst: Value{OID: OIDNumeric, Text: "1.25"}},
+		{
+			name: "uuid.UUID",
+			src:  uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			dst:  Value{OID: OIDUUID, Text: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		},
+		{
+			name: "time.Time",
+			src:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			dst:  Value{OID: OIDTimestamptz, Text: "2024-01-02 03:04:05Z"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Encode(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.dst, v)
+		})
+	}
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := Encode(struct{}{})
+		require.ErrorIs(t, err, errUnsupportedType)
+	})
+}
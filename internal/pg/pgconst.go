@@ -3,7 +3,21 @@ package pg
 const (
 	// https://github.com/postgres/postgres/blob/master/src/include/catalog/pg_type.dat
 
-	OIDInt4    = 23
-	OIDInt8    = 20
-	OIDUnknown = 705
+	OIDBool        = 16
+	OIDBytea       = 17
+	OIDInt8        = 20
+	OIDInt2        = 21
+	OIDInt4        = 23
+	OIDText        = 25
+	OIDJSON        = 114
+	OIDFloat4      = 700
+	OIDFloat8      = 701
+	OIDUnknown     = 705
+	OIDVarchar     = 1043
+	OIDDate        = 1082
+	OIDTimestamp   = 1114
+	OIDTimestamptz = 1184
+	OIDNumeric     = 1700
+	OIDUUID        = 2950
+	OIDJSONB       = 3802
 )
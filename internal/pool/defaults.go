@@ -1,6 +1,15 @@
 package pool
 
-const DefaultLimit = 50
+import (
+	"time"
+)
+
+const (
+	DefaultLimit = 50
+
+	// DefaultKeepInterval is a max interval between background checks of idle items
+	DefaultKeepInterval = time.Second
+)
 
 var defaultTrace = &Trace{
 	OnNew: func(info *NewStartInfo) func(info *NewDoneInfo) {
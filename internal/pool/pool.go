@@ -30,18 +30,31 @@ type (
 		v        *int
 		onChange func(func())
 	}
+	// nodeItem is an item which bound to cluster node
+	nodeItem interface {
+		NodeID() int64
+	}
+	idleItem[PT any] struct {
+		item  PT
+		since time.Time
+	}
 	Pool[PT Item[T], T any] struct {
-		trace *Trace
-		limit int
+		trace         *Trace
+		limit         int
+		minIdle       int
+		idleThreshold time.Duration
 
 		createItem    func(ctx context.Context) (PT, error)
 		createTimeout time.Duration
 		closeTimeout  time.Duration
 
 		mu    xsync.Mutex
-		idle  []PT
+		idle  []idleItem[PT]
 		index map[PT]struct{}
-		done  chan struct{}
+		inUse map[uint32]int // count of items in use by node ID
+		// evictedNodes are not alive nodes with items in use, such items are closed on return to pool
+		evictedNodes map[uint32]struct{}
+		done         chan struct{}
 
 		stats *safeStats
 	}
@@ -64,7 +77,42 @@ func (s *safeStats) Get() stats.Stats {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.v
+	v := s.v
+	if s.v.Nodes != nil {
+		v.Nodes = make(map[uint32]int, len(s.v.Nodes))
+		for nodeID, count := range s.v.Nodes {
+			v.Nodes[nodeID] = count
+		}
+	}
+
+	return v
+}
+
+func (s *safeStats) change(f func(v *stats.Stats)) {
+	s.mu.WithLock(func() {
+		f(&s.v)
+	})
+	if s.onChange != nil {
+		s.onChange(s.Get())
+	}
+}
+
+func (s *safeStats) AddNode(nodeID uint32, delta int) {
+	s.change(func(v *stats.Stats) {
+		if v.Nodes == nil {
+			v.Nodes = make(map[uint32]int)
+		}
+		v.Nodes[nodeID] += delta
+		if v.Nodes[nodeID] <= 0 {
+			delete(v.Nodes, nodeID)
+		}
+	})
+}
+
+func (s *safeStats) Evicted(count int) {
+	s.change(func(v *stats.Stats) {
+		v.Evicted += count
+	})
 }
 
 func (s *safeStats) Index() statsItemAddr {
@@ -136,6 +184,22 @@ func WithLimit[PT Item[T], T any](size int) option[PT, T] {
 	}
 }
 
+// WithMinIdle defines count of idle items which pool creates on start
+// and keeps in background regardless of idle threshold
+func WithMinIdle[PT Item[T], T any](size int) option[PT, T] {
+	return func(p *Pool[PT, T]) {
+		p.minIdle = size
+	}
+}
+
+// WithIdleThreshold defines max duration of item idleness. Items idled longer are closed in background.
+// If idleThreshold is less than or equal to zero then idle items are not evicted
+func WithIdleThreshold[PT Item[T], T any](idleThreshold time.Duration) option[PT, T] {
+	return func(p *Pool[PT, T]) {
+		p.idleThreshold = idleThreshold
+	}
+}
+
 func WithTrace[PT Item[T], T any](t *Trace) option[PT, T] {
 	return func(p *Pool[PT, T]) {
 		p.trace = t
@@ -216,9 +280,8 @@ func New[PT Item[T], T any](
 					defer p.mu.Unlock()
 
 					if len(p.index) < p.limit {
-						p.idle = append(p.idle, newItem)
-						p.index[newItem] = struct{}{}
-						p.stats.Index().Inc()
+						p.appendToIndex(newItem)
+						p.appendToIdle(newItem)
 						needCloseItem = false
 					}
 
@@ -249,16 +312,218 @@ func New[PT Item[T], T any](
 			return item, nil
 		}
 	}
-	p.idle = make([]PT, 0, p.limit)
+	if p.minIdle > p.limit {
+		p.minIdle = p.limit
+	}
+	p.idle = make([]idleItem[PT], 0, p.limit)
 	p.index = make(map[PT]struct{}, p.limit)
+	p.inUse = make(map[uint32]int)
+	p.evictedNodes = make(map[uint32]struct{})
 	p.stats = &safeStats{
-		v:        stats.Stats{Limit: p.limit},
+		v: stats.Stats{
+			Limit:   p.limit,
+			MinIdle: p.minIdle,
+		},
 		onChange: p.trace.OnChange,
 	}
 
+	if p.minIdle > 0 || p.idleThreshold > 0 {
+		go p.keep(xcontext.ValueOnly(ctx))
+	}
+
 	return p
 }
 
+func nodeID[PT Item[T], T any](item PT) uint32 {
+	if i, has := any(item).(nodeItem); has {
+		return uint32(i.NodeID())
+	}
+
+	return 0
+}
+
+// appendToIndex must be called under p.mu lock
+func (p *Pool[PT, T]) appendToIndex(item PT) {
+	p.index[item] = struct{}{}
+	p.stats.Index().Inc()
+	p.stats.AddNode(nodeID(item), 1)
+}
+
+// removeFromIndex must be called under p.mu lock
+func (p *Pool[PT, T]) removeFromIndex(item PT) {
+	if _, has := p.index[item]; !has {
+		return
+	}
+	delete(p.index, item)
+	p.stats.Index().Dec()
+	p.stats.AddNode(nodeID(item), -1)
+}
+
+// appendToIdle must be called under p.mu lock
+func (p *Pool[PT, T]) appendToIdle(item PT) {
+	p.idle = append(p.idle, idleItem[PT]{
+		item:  item,
+		since: time.Now(),
+	})
+	p.stats.Idle().Inc()
+}
+
+// takeIdle returns the most recently used idle item on node with the least count of items in use.
+// takeIdle must be called under p.mu lock
+func (p *Pool[PT, T]) takeIdle() (item PT) {
+	if len(p.idle) == 0 {
+		return nil
+	}
+	best := len(p.idle) - 1
+	for i := best - 1; i >= 0; i-- {
+		if p.inUse[nodeID(p.idle[i].item)] < p.inUse[nodeID(p.idle[best].item)] {
+			best = i
+		}
+	}
+	item = p.idle[best].item
+	p.idle = append(p.idle[:best], p.idle[best+1:]...)
+	p.stats.Idle().Dec()
+
+	return item
+}
+
+// keep evicts expired idle items and creates new items up to min idle size in background
+func (p *Pool[PT, T]) keep(ctx context.Context) {
+	interval := DefaultKeepInterval
+	if d := p.idleThreshold / 2; d > 0 && d < interval { //nolint:gomnd
+		interval = d
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		p.evict(ctx, func(item idleItem[PT], idleCount int) bool {
+			if !item.item.IsAlive() {
+				return true
+			}
+
+			return p.idleThreshold > 0 && idleCount > p.minIdle && time.Since(item.since) > p.idleThreshold
+		})
+		p.warmUp(ctx)
+
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// warmUp creates new idle items while count of idle items less than min idle size
+func (p *Pool[PT, T]) warmUp(ctx context.Context) {
+	for {
+		var needMore bool
+		p.mu.WithLock(func() {
+			needMore = len(p.idle) < p.minIdle && len(p.index) < p.limit
+		})
+		if !needMore {
+			return
+		}
+
+		item, err := p.createItem(ctx)
+		if err != nil {
+			return
+		}
+
+		added := false
+		p.mu.WithLock(func() {
+			select {
+			case <-p.done:
+			default:
+				if len(p.index) < p.limit {
+					p.appendToIndex(item)
+					p.appendToIdle(item)
+					added = true
+				}
+			}
+		})
+		if !added {
+			_ = p.closeItem(ctx, item)
+
+			return
+		}
+	}
+}
+
+// evict closes idle items which matched to needEvict. Idle items are checked from the oldest one
+func (p *Pool[PT, T]) evict(ctx context.Context, needEvict func(item idleItem[PT], idleCount int) bool) (evicted int) {
+	var items []PT
+	p.mu.WithLock(func() {
+		idle := p.idle[:0]
+		for i := range p.idle {
+			if needEvict(p.idle[i], len(p.idle)-len(items)) {
+				items = append(items, p.idle[i].item)
+				p.removeFromIndex(p.idle[i].item)
+				p.stats.Idle().Dec()
+			} else {
+				idle = append(idle, p.idle[i])
+			}
+		}
+		for i := len(idle); i < len(p.idle); i++ {
+			p.idle[i] = idleItem[PT]{}
+		}
+		p.idle = idle
+	})
+
+	for _, item := range items {
+		_ = p.closeItem(ctx, item)
+	}
+
+	if len(items) > 0 {
+		p.stats.Evicted(len(items))
+	}
+
+	return len(items)
+}
+
+// EvictNodes closes idle items on nodes which is not alive (for example, nodes which disappeared from discovery).
+// Items in use on such nodes will be closed on returning to pool
+func (p *Pool[PT, T]) EvictNodes(ctx context.Context, isAlive func(nodeID uint32) bool) (evicted int) {
+	select {
+	case <-p.done:
+		return 0
+	default:
+		p.markEvictedNodes(isAlive)
+
+		return p.evict(ctx, func(item idleItem[PT], _ int) bool {
+			return !isAlive(nodeID(item.item))
+		})
+	}
+}
+
+// markEvictedNodes remembers not alive nodes of items in use and forgets nodes which are alive again
+func (p *Pool[PT, T]) markEvictedNodes(isAlive func(nodeID uint32) bool) {
+	nodes := make(map[uint32]bool)
+	p.mu.WithLock(func() {
+		for id := range p.inUse {
+			nodes[id] = true
+		}
+		for id := range p.evictedNodes {
+			nodes[id] = true
+		}
+	})
+
+	// isAlive called without lock of pool, because it is external callback
+	for id := range nodes {
+		nodes[id] = isAlive(id)
+	}
+
+	p.mu.WithLock(func() {
+		for id, alive := range nodes {
+			if _, has := p.inUse[id]; has && !alive {
+				p.evictedNodes[id] = struct{}{}
+			} else {
+				delete(p.evictedNodes, id)
+			}
+		}
+	})
+}
+
 func (p *Pool[PT, T]) Stats() stats.Stats {
 	return p.stats.Get()
 }
@@ -284,23 +549,29 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (_ PT, finalErr error) {
 	case <-ctx.Done():
 		return nil, xerrors.WithStackTrace(ctx.Err())
 	default:
-		var item PT
+		var (
+			item    PT
+			evicted bool
+		)
 		p.mu.WithLock(func() {
-			if len(p.idle) > 0 {
-				item, p.idle = p.idle[0], p.idle[1:]
-				p.stats.Idle().Dec()
+			item = p.takeIdle()
+			if item != nil {
+				_, evicted = p.evictedNodes[nodeID(item)]
 			}
 		})
 
 		if item != nil {
-			if item.IsAlive() {
+			if !evicted && item.IsAlive() {
+				p.mu.WithLock(func() {
+					p.inUse[nodeID(item)]++
+				})
+
 				return item, nil
 			}
 			_ = p.closeItem(ctx, item)
 			p.mu.WithLock(func() {
-				delete(p.index, item)
+				p.removeFromIndex(item)
 			})
-			p.stats.Index().Dec()
 		}
 
 		item, err := p.createItem(ctx)
@@ -308,16 +579,12 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (_ PT, finalErr error) {
 			return nil, xerrors.WithStackTrace(err)
 		}
 
-		addedToIndex := false
 		p.mu.WithLock(func() {
 			if len(p.index) < p.limit {
-				p.index[item] = struct{}{}
-				addedToIndex = true
+				p.appendToIndex(item)
 			}
+			p.inUse[nodeID(item)]++
 		})
-		if addedToIndex {
-			p.stats.Index().Inc()
-		}
 
 		return item, nil
 	}
@@ -334,6 +601,19 @@ func (p *Pool[PT, T]) putItem(ctx context.Context, item PT) (finalErr error) {
 		})
 	}()
 
+	var evicted bool
+	p.mu.WithLock(func() {
+		id := nodeID(item)
+		_, evicted = p.evictedNodes[id]
+		if p.inUse[id] > 1 {
+			p.inUse[id]--
+		} else {
+			delete(p.inUse, id)
+			// last item in use on the evicted node returned, idle items of the node are evicted already
+			delete(p.evictedNodes, id)
+		}
+	})
+
 	if err := ctx.Err(); err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -342,21 +622,22 @@ func (p *Pool[PT, T]) putItem(ctx context.Context, item PT) (finalErr error) {
 	case <-p.done:
 		return xerrors.WithStackTrace(errClosedPool)
 	default:
-		if !item.IsAlive() {
+		if evicted || !item.IsAlive() {
 			_ = p.closeItem(ctx, item)
 
 			p.mu.WithLock(func() {
-				delete(p.index, item)
+				p.removeFromIndex(item)
 			})
-			p.stats.Index().Dec()
+			if evicted {
+				p.stats.Evicted(1)
+			}
 
 			return xerrors.WithStackTrace(errItemIsNotAlive)
 		}
 
 		p.mu.WithLock(func() {
-			p.idle = append(p.idle, item)
+			p.appendToIdle(item)
 		})
-		p.stats.Idle().Inc()
 
 		return nil
 	}
@@ -19,7 +19,8 @@ import (
 )
 
 type testItem struct {
-	v uint32
+	v      uint32
+	nodeID int64
 
 	onClose   func() error
 	onIsAlive func() bool
@@ -37,6 +38,10 @@ func (t testItem) ID() string {
 	return ""
 }
 
+func (t testItem) NodeID() int64 {
+	return t.nodeID
+}
+
 func (t testItem) Close(context.Context) error {
 	if t.onClose != nil {
 		return t.onClose()
@@ -291,6 +296,197 @@ func TestPool(t *testing.T) {
 	})
 }
 
+func TestPoolMinIdle(t *testing.T) {
+	ctx := xtest.Context(t)
+	var created int64
+	p := New(ctx,
+		WithLimit[*testItem, testItem](10),
+		WithMinIdle[*testItem, testItem](3),
+		WithCreateFunc(func(context.Context) (*testItem, error) {
+			return &testItem{
+				nodeID: atomic.AddInt64(&created, 1) % 2,
+			}, nil
+		}),
+	)
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return p.Stats().Idle == 3
+	})
+	s := p.Stats()
+	require.Equal(t, 3, s.MinIdle)
+	require.Equal(t, 3, s.Index)
+	require.Equal(t, map[uint32]int{0: 1, 1: 2}, s.Nodes)
+	require.EqualValues(t, 3, atomic.LoadInt64(&created))
+}
+
+func TestPoolIdleThreshold(t *testing.T) {
+	ctx := xtest.Context(t)
+	var closed int64
+	p := New(ctx,
+		WithLimit[*testItem, testItem](10),
+		WithMinIdle[*testItem, testItem](1),
+		WithIdleThreshold[*testItem, testItem](10*time.Millisecond),
+		WithCreateFunc(func(context.Context) (*testItem, error) {
+			return &testItem{
+				onClose: func() error {
+					atomic.AddInt64(&closed, 1)
+
+					return nil
+				},
+			}, nil
+		}),
+	)
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+	var wg sync.WaitGroup
+	wg.Add(5)
+	for range make([]struct{}, 5) {
+		go func() {
+			defer wg.Done()
+			err := p.With(ctx, func(ctx context.Context, item *testItem) error {
+				time.Sleep(10 * time.Millisecond)
+
+				return nil
+			})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return p.Stats().Idle == 1
+	})
+	s := p.Stats()
+	require.Equal(t, 1, s.Index)
+	require.Equal(t, s.Evicted, int(atomic.LoadInt64(&closed)))
+	require.Positive(t, s.Evicted)
+}
+
+func TestPoolEvictNodes(t *testing.T) {
+	ctx := xtest.Context(t)
+	var created int64
+	p := New(ctx,
+		WithLimit[*testItem, testItem](10),
+		WithMinIdle[*testItem, testItem](4),
+		WithCreateFunc(func(context.Context) (*testItem, error) {
+			return &testItem{
+				nodeID: atomic.AddInt64(&created, 1) % 2,
+			}, nil
+		}),
+	)
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		return p.Stats().Idle == 4
+	})
+	evicted := p.EvictNodes(ctx, func(nodeID uint32) bool {
+		return nodeID != 1
+	})
+	require.Equal(t, 2, evicted)
+	s := p.Stats()
+	require.Equal(t, 2, s.Evicted)
+	require.Equal(t, 2, s.Index)
+	require.Equal(t, map[uint32]int{0: 2}, s.Nodes)
+	err := p.With(ctx, func(ctx context.Context, item *testItem) error {
+		require.EqualValues(t, 0, item.NodeID())
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPoolEvictNodesWithItemInUse(t *testing.T) {
+	ctx := xtest.Context(t)
+	var closed int64
+	p := New[*testItem, testItem](ctx, WithLimit[*testItem, testItem](10))
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+	closable := func(nodeID int64) *testItem {
+		return &testItem{
+			nodeID: nodeID,
+			onClose: func() error {
+				atomic.AddInt64(&closed, 1)
+
+				return nil
+			},
+		}
+	}
+	p.mu.WithLock(func() {
+		for _, nodeID := range []int64{1, 2} {
+			item := closable(nodeID)
+			p.appendToIndex(item)
+			p.appendToIdle(item)
+		}
+	})
+	inUse, err := p.getItem(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, inUse.NodeID())
+
+	evicted := p.EvictNodes(ctx, func(nodeID uint32) bool {
+		return nodeID != 2
+	})
+	require.Zero(t, evicted)
+	require.Zero(t, atomic.LoadInt64(&closed))
+
+	require.ErrorIs(t, p.putItem(ctx, inUse), errItemIsNotAlive)
+	require.EqualValues(t, 1, atomic.LoadInt64(&closed))
+	s := p.Stats()
+	require.Equal(t, 1, s.Evicted)
+	require.Equal(t, 1, s.Index)
+	require.Equal(t, 1, s.Idle)
+	require.Equal(t, map[uint32]int{1: 1}, s.Nodes)
+
+	// items of the node which is alive again are not evicted
+	p.mu.WithLock(func() {
+		item := closable(2)
+		p.appendToIndex(item)
+		p.appendToIdle(item)
+	})
+	inUse, err = p.getItem(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, inUse.NodeID())
+	p.EvictNodes(ctx, func(nodeID uint32) bool {
+		return nodeID != 2
+	})
+	p.EvictNodes(ctx, func(nodeID uint32) bool {
+		return true
+	})
+	require.NoError(t, p.putItem(ctx, inUse))
+	require.EqualValues(t, 1, atomic.LoadInt64(&closed))
+}
+
+func TestPoolTakeIdleFromLeastLoadedNode(t *testing.T) {
+	ctx := xtest.Context(t)
+	p := New[*testItem, testItem](ctx, WithLimit[*testItem, testItem](10))
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+	p.mu.WithLock(func() {
+		for _, nodeID := range []int64{1, 1, 2} {
+			item := &testItem{nodeID: nodeID}
+			p.appendToIndex(item)
+			p.appendToIdle(item)
+		}
+	})
+	first, err := p.getItem(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, first.NodeID())
+	second, err := p.getItem(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, second.NodeID())
+	third, err := p.getItem(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, third.NodeID())
+	require.NoError(t, p.putItem(ctx, first))
+	require.NoError(t, p.putItem(ctx, second))
+	require.NoError(t, p.putItem(ctx, third))
+	require.Equal(t, 3, p.Stats().Idle)
+}
+
 func TestSafeStatsRace(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
 		var (
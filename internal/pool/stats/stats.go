@@ -1,8 +1,15 @@
 package stats
 
 type Stats struct {
-	Limit int
-	Index int
-	Idle  int
-	InUse int
+	Limit   int
+	MinIdle int
+	Index   int
+	Idle    int
+	InUse   int
+
+	// Evicted is a count of items which closed by pool because of idle threshold or disappeared node
+	Evicted int
+
+	// Nodes is a count of items in index by node ID
+	Nodes map[uint32]int
 }
@@ -2,10 +2,14 @@ package query
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
@@ -27,14 +31,19 @@ type nodeChecker interface {
 type balancer interface {
 	grpc.ClientConnInterface
 	nodeChecker
+
+	OnUpdate(onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info)) (unsubscribe func())
 }
 
 var _ query.Client = (*Client)(nil)
 
 type Client struct {
-	config     *config.Config
-	grpcClient Ydb_Query_V1.QueryServiceClient
-	pool       *pool.Pool[*Session, Session]
+	config          *config.Config
+	grpcClient      Ydb_Query_V1.QueryServiceClient
+	operationClient *operation.Client
+	pool            *pool.Pool[*Session, Session]
+
+	unsubscribeBalancer func()
 
 	done chan struct{}
 }
@@ -48,6 +57,10 @@ func (c *Client) Stats() *stats.Stats {
 func (c *Client) Close(ctx context.Context) error {
 	close(c.done)
 
+	if c.unsubscribeBalancer != nil {
+		c.unsubscribeBalancer()
+	}
+
 	err := c.pool.Close(ctx)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
@@ -119,10 +132,13 @@ func doTx(
 	doTxOpts := options.ParseDoTxOpts(t, opts...)
 
 	attempts, err = do(ctx, pool, func(ctx context.Context, s query.Session) (err error) {
-		tx, err := s.Begin(ctx, doTxOpts.TxSettings())
-		if err != nil {
-			return xerrors.WithStackTrace(err)
+		// transaction begins with first execute and may be committed with last execute (query.WithCommit),
+		// so separated BeginTransaction and CommitTransaction calls are not needed
+		session, ok := s.(*Session)
+		if !ok {
+			return xerrors.WithStackTrace(fmt.Errorf("%T is not a *query.Session", s))
 		}
+		tx := newLazyTransaction(session, doTxOpts.TxSettings())
 		err = op(ctx, tx)
 		if err != nil {
 			errRollback := tx.Rollback(ctx)
@@ -166,6 +182,115 @@ func (c *Client) DoTx(ctx context.Context, op query.TxOperation, opts ...options
 	}
 }
 
+func readResultSet(
+	ctx context.Context,
+	pool *pool.Pool[*Session, Session],
+	q string,
+	t *trace.Query,
+	opts ...options.ReadOption,
+) (rs *materializedResultSet, attempts int, finalErr error) {
+	var (
+		settings        = options.ParseReadOpts(opts...)
+		resultSetsCount int
+	)
+	attempts, err := do(ctx, pool, func(ctx context.Context, s query.Session) (err error) {
+		rs, resultSetsCount, err = readMaterializedResultSet(ctx, s, q, settings.ExecuteOpts()...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}, t, settings.DoOpts()...)
+	if err != nil {
+		return nil, attempts, xerrors.WithStackTrace(err)
+	}
+
+	switch {
+	case resultSetsCount == 0:
+		return nil, attempts, xerrors.WithStackTrace(errNoResultSets)
+	case resultSetsCount > 1:
+		return nil, attempts, xerrors.WithStackTrace(
+			fmt.Errorf("%w: received %d result sets", errMoreThanOneResultSet, resultSetsCount),
+		)
+	default:
+		return rs, attempts, nil
+	}
+}
+
+// ReadResultSet executes query with retries and reads single result set of result into memory.
+// Returns error if result contains not exactly one result set
+func (c *Client) ReadResultSet(ctx context.Context, q string, opts ...options.ReadOption) (
+	query.ResultSet, error,
+) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+		onDone := trace.QueryOnDo(c.config.Trace(), &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.(*Client).ReadResultSet"),
+		)
+		rs, attempts, err := readResultSet(ctx, c.pool, q, c.config.Trace(), opts...)
+		onDone(attempts, err)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return rs, nil
+	}
+}
+
+// ReadRow executes query with retries and reads single row of single result set of result into memory.
+// Returns error if result contains not exactly one result set or result set contains not exactly one row
+func (c *Client) ReadRow(ctx context.Context, q string, opts ...options.ReadOption) (_ query.Row, finalErr error) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+		onDone := trace.QueryOnDo(c.config.Trace(), &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.(*Client).ReadRow"),
+		)
+		rs, attempts, err := readResultSet(ctx, c.pool, q, c.config.Trace(), opts...)
+		defer func() {
+			onDone(attempts, finalErr)
+		}()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		switch len(rs.rows) {
+		case 0:
+			return nil, xerrors.WithStackTrace(errNoRows)
+		case 1:
+			return rs.rows[0], nil
+		default:
+			return nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: received %d rows", errMoreThanOneRow, len(rs.rows)),
+			)
+		}
+	}
+}
+
+// CreateSession creates a standalone session which is not tracked by the client pool.
+// Caller owns returned session and must close it by itself.
+func CreateSession(ctx context.Context, c query.Client) (*Session, error) {
+	client, ok := c.(*Client)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%T is not a *query.Client", c))
+	}
+
+	select {
+	case <-client.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+		s, err := createSession(ctx, client.grpcClient, client.config)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return s, nil
+	}
+}
+
 func New(ctx context.Context, balancer balancer, cfg *config.Config) *Client {
 	onDone := trace.QueryOnNew(cfg.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.New"),
@@ -173,13 +298,16 @@ func New(ctx context.Context, balancer balancer, cfg *config.Config) *Client {
 	defer onDone()
 
 	client := &Client{
-		config:     cfg,
-		grpcClient: Ydb_Query_V1.NewQueryServiceClient(balancer),
-		done:       make(chan struct{}),
+		config:          cfg,
+		grpcClient:      Ydb_Query_V1.NewQueryServiceClient(balancer),
+		operationClient: operation.New(balancer, operationConfig.New(operationConfig.With(cfg.Common))),
+		done:            make(chan struct{}),
 	}
 
 	client.pool = pool.New(ctx,
 		pool.WithLimit[*Session, Session](cfg.PoolLimit()),
+		pool.WithMinIdle[*Session, Session](cfg.PoolMinIdle()),
+		pool.WithIdleThreshold[*Session, Session](cfg.PoolIdleThreshold()),
 		pool.WithTrace[*Session, Session](poolTrace(cfg.Trace())),
 		pool.WithCreateItemTimeout[*Session, Session](cfg.SessionCreateTimeout()),
 		pool.WithCloseItemTimeout[*Session, Session](cfg.SessionDeleteTimeout()),
@@ -208,6 +336,20 @@ func New(ctx context.Context, balancer balancer, cfg *config.Config) *Client {
 		}),
 	)
 
+	// balancer calls callbacks under lock, so sessions on nodes which disappeared from discovery
+	// are closed in background
+	client.unsubscribeBalancer = balancer.OnUpdate(func(ctx context.Context, endpoints []endpoint.Info) {
+		nodes := make(map[uint32]struct{}, len(endpoints))
+		for _, e := range endpoints {
+			nodes[e.NodeID()] = struct{}{}
+		}
+		go client.pool.EvictNodes(xcontext.ValueOnly(ctx), func(nodeID uint32) bool {
+			_, has := nodes[nodeID]
+
+			return has
+		})
+	})
+
 	return client
 }
 
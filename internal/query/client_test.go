@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
@@ -213,13 +215,117 @@ func TestDoTx(t *testing.T) {
 	ctx := xtest.Context(t)
 	t.Run("HappyWay", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		client := NewMockQueryServiceClient(ctrl)
-		client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
 			Status: Ydb.StatusIds_SUCCESS,
+			TxMeta: &Ydb_Query.TransactionMeta{
+				Id: "456",
+			},
 		}, nil)
-		client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+		stream.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.NotNil(t, in.GetTxControl().GetBeginTx())
+				require.False(t, in.GetTxControl().GetCommitTx())
+
+				return stream, nil
+			},
+		)
+		client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.CommitTransactionRequest, opts ...grpc.CallOption) (
+				*Ydb_Query.CommitTransactionResponse, error,
+			) {
+				require.Equal(t, "456", in.GetTxId())
+
+				return &Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil
+			},
+		)
+		var beginInlined, commitInlined bool
+		session := newTestSessionWithClient("123", client)
+		session.cfg = config.New(config.WithTrace(&trace.Query{
+			OnTxExecute: func(info trace.QueryTxExecuteStartInfo) func(trace.QueryTxExecuteDoneInfo) {
+				beginInlined, commitInlined = info.BeginInlined, info.CommitInlined
+
+				return nil
+			},
+		}))
+		attempts, err := doTx(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+			return session, nil
+		}), func(ctx context.Context, tx query.TxActor) error {
+			require.Empty(t, tx.ID())
+			res, err := tx.Execute(ctx, "SELECT 1")
+			if err != nil {
+				return err
+			}
+			require.Equal(t, "456", tx.ID())
+
+			return res.Close(ctx)
+		}, &trace.Query{})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, attempts)
+		require.True(t, beginInlined)
+		require.False(t, commitInlined)
+	})
+	t.Run("InlinedCommit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
 			Status: Ydb.StatusIds_SUCCESS,
+			TxMeta: &Ydb_Query.TransactionMeta{
+				Id: "456",
+			},
 		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.NotNil(t, in.GetTxControl().GetBeginTx())
+				require.True(t, in.GetTxControl().GetCommitTx())
+
+				return stream, nil
+			},
+		)
+		var beginInlined, commitInlined bool
+		session := newTestSessionWithClient("123", client)
+		session.cfg = config.New(config.WithTrace(&trace.Query{
+			OnTxExecute: func(info trace.QueryTxExecuteStartInfo) func(trace.QueryTxExecuteDoneInfo) {
+				if !beginInlined {
+					beginInlined, commitInlined = info.BeginInlined, info.CommitInlined
+				}
+
+				return nil
+			},
+		}))
+		attempts, err := doTx(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+			return session, nil
+		}), func(ctx context.Context, tx query.TxActor) error {
+			res, err := tx.Execute(ctx, "SELECT 1", query.WithCommit())
+			if err != nil {
+				return err
+			}
+			if err = res.Close(ctx); err != nil {
+				return err
+			}
+			_, err = tx.Execute(ctx, "SELECT 2")
+			require.ErrorIs(t, err, errTxAlreadyCommitted)
+
+			return nil
+		}, &trace.Query{})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, attempts)
+		require.True(t, beginInlined)
+		require.True(t, commitInlined)
+	})
+	t.Run("NoQueries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
 		attempts, err := doTx(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
 			return newTestSessionWithClient("123", client), nil
 		}), func(ctx context.Context, tx query.TxActor) error {
@@ -256,3 +362,115 @@ func TestDoTx(t *testing.T) {
 		require.Equal(t, 10, counter)
 	})
 }
+
+func testExecuteQueryParts(resultSets ...[]uint64) (parts []*Ydb_Query.ExecuteQueryResponsePart) {
+	for i, rows := range resultSets {
+		part := &Ydb_Query.ExecuteQueryResponsePart{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: int64(i),
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{
+						Name: "a",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UINT64,
+							},
+						},
+					},
+				},
+			},
+		}
+		for _, v := range rows {
+			part.ResultSet.Rows = append(part.ResultSet.Rows, &Ydb.Value{
+				Items: []*Ydb.Value{{
+					Value: &Ydb.Value_Uint64Value{
+						Uint64Value: v,
+					},
+				}},
+			})
+		}
+		parts = append(parts, part)
+	}
+
+	return parts
+}
+
+func testClientWithExecuteQueryParts(
+	ctx context.Context, t *testing.T, parts []*Ydb_Query.ExecuteQueryResponsePart,
+) *Client {
+	ctrl := gomock.NewController(t)
+	stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+	for _, part := range parts {
+		stream.EXPECT().Recv().Return(part, nil)
+	}
+	stream.EXPECT().Recv().Return(nil, io.EOF)
+	client := NewMockQueryServiceClient(ctrl)
+	client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+	return &Client{
+		config: config.New(),
+		pool: testPool(ctx, func(ctx context.Context) (*Session, error) {
+			return newTestSessionWithClient("123", client), nil
+		}),
+		done: make(chan struct{}),
+	}
+}
+
+func TestClientReadResultSet(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("HappyWay", func(t *testing.T) {
+		c := testClientWithExecuteQueryParts(ctx, t, testExecuteQueryParts([]uint64{1, 2, 3}))
+		rs, err := c.ReadResultSet(ctx, "SELECT 1")
+		require.NoError(t, err)
+		require.Equal(t, 0, rs.Index())
+		require.Equal(t, []string{"a"}, rs.Columns())
+		var values []uint64
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+
+				break
+			}
+			var v uint64
+			require.NoError(t, row.Scan(&v))
+			values = append(values, v)
+		}
+		require.Equal(t, []uint64{1, 2, 3}, values)
+	})
+	t.Run("NoResultSets", func(t *testing.T) {
+		c := testClientWithExecuteQueryParts(ctx, t, []*Ydb_Query.ExecuteQueryResponsePart{{
+			Status: Ydb.StatusIds_SUCCESS,
+		}})
+		_, err := c.ReadResultSet(ctx, "UPSERT INTO t (a) VALUES (1)")
+		require.ErrorIs(t, err, errNoResultSets)
+	})
+	t.Run("MoreThanOneResultSet", func(t *testing.T) {
+		c := testClientWithExecuteQueryParts(ctx, t, testExecuteQueryParts([]uint64{1}, []uint64{2}))
+		_, err := c.ReadResultSet(ctx, "SELECT 1; SELECT 2")
+		require.ErrorIs(t, err, errMoreThanOneResultSet)
+	})
+}
+
+func TestClientReadRow(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("HappyWay", func(t *testing.T) {
+		c := testClientWithExecuteQueryParts(ctx, t, testExecuteQueryParts([]uint64{42}))
+		row, err := c.ReadRow(ctx, "SELECT 42")
+		require.NoError(t, err)
+		var v uint64
+		require.NoError(t, row.Scan(&v))
+		require.EqualValues(t, 42, v)
+	})
+	t.Run("NoRows", func(t *testing.T) {
+		c := testClientWithExecuteQueryParts(ctx, t, testExecuteQueryParts([]uint64{}))
+		_, err := c.ReadRow(ctx, "SELECT 42 WHERE false")
+		require.ErrorIs(t, err, errNoRows)
+	})
+	t.Run("MoreThanOneRow", func(t *testing.T) {
+		c := testClientWithExecuteQueryParts(ctx, t, testExecuteQueryParts([]uint64{1, 2}))
+		_, err := c.ReadRow(ctx, "SELECT 1 UNION ALL SELECT 2")
+		require.ErrorIs(t, err, errMoreThanOneRow)
+	})
+}
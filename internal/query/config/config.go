@@ -17,7 +17,9 @@ const (
 type Config struct {
 	config.Common
 
-	poolLimit int
+	poolLimit         int
+	poolMinIdle       int
+	poolIdleThreshold time.Duration
 
 	sessionCreateTimeout time.Duration
 	sessionDeleteTimeout time.Duration
@@ -57,6 +59,18 @@ func (c *Config) PoolLimit() int {
 	return c.poolLimit
 }
 
+// PoolMinIdle is a count of idle sessions which pool creates on start and keeps in background
+func (c *Config) PoolMinIdle() int {
+	return c.poolMinIdle
+}
+
+// PoolIdleThreshold is a max duration of session idleness in pool.
+// Sessions idled longer are closed in background (excluding PoolMinIdle sessions).
+// If PoolIdleThreshold is zero then idle sessions are not evicted
+func (c *Config) PoolIdleThreshold() time.Duration {
+	return c.poolIdleThreshold
+}
+
 // SessionCreateTimeout limits maximum time spent on Create session request
 func (c *Config) SessionCreateTimeout() time.Duration {
 	return c.sessionCreateTimeout
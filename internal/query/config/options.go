@@ -34,6 +34,31 @@ func WithPoolLimit(size int) Option {
 	}
 }
 
+// WithPoolMinIdle defines count of idle sessions which pool creates on start and keeps in background.
+// If minIdle is greater than pool limit then pool limit is used
+func WithPoolMinIdle(minIdle int) Option {
+	return func(c *Config) {
+		if minIdle > 0 {
+			c.poolMinIdle = minIdle
+		} else {
+			c.poolMinIdle = 0
+		}
+	}
+}
+
+// WithPoolIdleThreshold defines max duration of session idleness in pool.
+// Sessions idled longer are closed in background (excluding min idle sessions).
+// If idleThreshold is less than or equal to zero then idle sessions are not evicted
+func WithPoolIdleThreshold(idleThreshold time.Duration) Option {
+	return func(c *Config) {
+		if idleThreshold > 0 {
+			c.poolIdleThreshold = idleThreshold
+		} else {
+			c.poolIdleThreshold = 0
+		}
+	}
+}
+
 // WithSessionCreateTimeout limits maximum time spent on Create session request
 // If sessionCreateTimeout is less than or equal to zero then no used timeout on create session request
 func WithSessionCreateTimeout(createSessionTimeout time.Duration) Option {
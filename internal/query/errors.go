@@ -10,4 +10,11 @@ var (
 	errClosedResult            = errors.New("result closed early")
 	errClosedClient            = errors.New("query client closed early")
 	errWrongResultSetIndex     = errors.New("critical violation of the logic - wrong result set index")
+	errNoResultSets            = errors.New("no result sets")
+	errMoreThanOneResultSet    = errors.New("unexpected more than one result set")
+	errNoRows                  = errors.New("no rows in result set")
+	errMoreThanOneRow          = errors.New("unexpected more than one row in result set")
+	errNoTxID                  = errors.New("no transaction id in result of execute with inlined begin of transaction")
+	errTxAlreadyCommitted      = errors.New("transaction already committed")
+	errTxRollbacked            = errors.New("transaction rollbacked")
 )
@@ -0,0 +1,96 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+var _ query.ResultSet = (*materializedResultSet)(nil)
+
+// materializedResultSet is a result set which fully read into memory
+type materializedResultSet struct {
+	index       int
+	columns     []string
+	columnTypes []types.Type
+	rows        []query.Row
+	rowIndex    int
+}
+
+func (rs *materializedResultSet) Index() int {
+	return rs.index
+}
+
+func (rs *materializedResultSet) Columns() []string {
+	return rs.columns
+}
+
+func (rs *materializedResultSet) ColumnTypes() []types.Type {
+	return rs.columnTypes
+}
+
+func (rs *materializedResultSet) NextRow(context.Context) (query.Row, error) {
+	if rs.rowIndex == len(rs.rows) {
+		return nil, xerrors.WithStackTrace(io.EOF)
+	}
+
+	rs.rowIndex++
+
+	return rs.rows[rs.rowIndex-1], nil
+}
+
+// readMaterializedResultSet executes query and reads first result set into memory.
+// Other result sets are drained and only counted for checks on caller side
+func readMaterializedResultSet(ctx context.Context, s query.Session, q string, opts ...options.ExecuteOption) (
+	_ *materializedResultSet, resultSetsCount int, finalErr error,
+) {
+	_, r, err := s.Execute(ctx, q, opts...)
+	if err != nil {
+		return nil, 0, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = r.Close(ctx)
+	}()
+
+	var materialized *materializedResultSet
+	for {
+		rs, err := r.NextResultSet(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, 0, xerrors.WithStackTrace(err)
+		}
+		resultSetsCount++
+		if resultSetsCount == 1 {
+			materialized = &materializedResultSet{
+				index:       rs.Index(),
+				columns:     rs.Columns(),
+				columnTypes: rs.ColumnTypes(),
+			}
+		}
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				if xerrors.Is(err, io.EOF) {
+					break
+				}
+
+				return nil, 0, xerrors.WithStackTrace(err)
+			}
+			if resultSetsCount == 1 {
+				materialized.rows = append(materialized.rows, row)
+			}
+		}
+	}
+	if err = r.Err(); err != nil {
+		return nil, 0, xerrors.WithStackTrace(err)
+	}
+
+	return materialized, resultSetsCount, nil
+}
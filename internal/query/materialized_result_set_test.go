@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+type closedEarlyResult struct {
+	query.Result
+}
+
+func (r closedEarlyResult) NextResultSet(context.Context) (query.ResultSet, error) {
+	return nil, xerrors.WithStackTrace(errClosedResult)
+}
+
+func (r closedEarlyResult) Close(context.Context) error {
+	return nil
+}
+
+type closedEarlySession struct {
+	query.Session
+}
+
+func (s closedEarlySession) Execute(context.Context, string, ...options.ExecuteOption) (
+	query.Transaction, query.Result, error,
+) {
+	return nil, closedEarlyResult{}, nil
+}
+
+func TestReadMaterializedResultSetClosedEarly(t *testing.T) {
+	_, _, err := readMaterializedResultSet(xtest.Context(t), closedEarlySession{}, "SELECT 1")
+	require.ErrorIs(t, err, errClosedResult)
+}
@@ -178,6 +178,11 @@ func TxExecuteSettings(id string, opts ...TxExecuteOption) (settings *txExecuteS
 	return settings
 }
 
+// CommitTx reports whether transaction must be committed with execute (inlined commit)
+func (s *txExecuteSettings) CommitTx() bool {
+	return s.commitTx
+}
+
 var _ ExecuteOption = ParametersOption{}
 
 func WithParameters(parameters *params.Parameters) ParametersOption {
@@ -0,0 +1,75 @@
+package options
+
+var (
+	_ ReadOption = Syntax(0)
+	_ ReadOption = ParametersOption{}
+	_ ReadOption = CallOptions{}
+	_ ReadOption = StatsMode(0)
+	_ ReadOption = ExecMode(0)
+	_ ReadOption = TxControlOption{}
+	_ ReadOption = retryOptionsOption(nil)
+	_ ReadOption = traceOption{}
+)
+
+type (
+	// ReadOption is an option of helpers such as query.Client.ReadRow which executes query with retries.
+	// Both execute options and retry options are read options
+	ReadOption interface {
+		applyReadOption(s *readSettings)
+	}
+	readSettings struct {
+		executeOpts []ExecuteOption
+		doOpts      []DoOption
+	}
+)
+
+func (s *readSettings) ExecuteOpts() []ExecuteOption {
+	return s.executeOpts
+}
+
+func (s *readSettings) DoOpts() []DoOption {
+	return s.doOpts
+}
+
+func (syntax Syntax) applyReadOption(s *readSettings) {
+	s.executeOpts = append(s.executeOpts, syntax)
+}
+
+func (params ParametersOption) applyReadOption(s *readSettings) {
+	s.executeOpts = append(s.executeOpts, params)
+}
+
+func (opts CallOptions) applyReadOption(s *readSettings) {
+	s.executeOpts = append(s.executeOpts, opts)
+}
+
+func (mode StatsMode) applyReadOption(s *readSettings) {
+	s.executeOpts = append(s.executeOpts, mode)
+}
+
+func (mode ExecMode) applyReadOption(s *readSettings) {
+	s.executeOpts = append(s.executeOpts, mode)
+}
+
+func (opt TxControlOption) applyReadOption(s *readSettings) {
+	s.executeOpts = append(s.executeOpts, opt)
+}
+
+func (opts retryOptionsOption) applyReadOption(s *readSettings) {
+	s.doOpts = append(s.doOpts, opts)
+}
+
+func (opt traceOption) applyReadOption(s *readSettings) {
+	s.doOpts = append(s.doOpts, opt)
+}
+
+func ParseReadOpts(opts ...ReadOption) (s *readSettings) {
+	s = &readSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.applyReadOption(s)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,21 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestParseReadOpts(t *testing.T) {
+	s := ParseReadOpts(
+		WithSyntax(SyntaxPostgreSQL),
+		WithIdempotent(),
+		WithTrace(&trace.Query{}),
+		nil,
+	)
+	require.Len(t, s.ExecuteOpts(), 1)
+	require.Len(t, s.DoOpts(), 2)
+	require.Equal(t, SyntaxPostgreSQL, ExecuteSettings(s.ExecuteOpts()...).Syntax())
+}
@@ -0,0 +1,69 @@
+package options
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+)
+
+type (
+	ExecStatus          Ydb_Query.ExecStatus
+	FetchScriptSettings struct {
+		resultSetIndex int64
+		fetchToken     string
+		rowsLimit      int64
+	}
+	FetchScriptOption func(s *FetchScriptSettings)
+)
+
+const (
+	ExecStatusUnspecified = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_UNSPECIFIED)
+	ExecStatusStarting    = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_STARTING)
+	ExecStatusAborted     = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_ABORTED)
+	ExecStatusCanceled    = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_CANCELLED)
+	ExecStatusCompleted   = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_COMPLETED)
+	ExecStatusFailed      = ExecStatus(Ydb_Query.ExecStatus_EXEC_STATUS_FAILED)
+)
+
+func (status ExecStatus) String() string {
+	return Ydb_Query.ExecStatus(status).String()
+}
+
+func WithResultSetIndex(index int64) FetchScriptOption {
+	return func(s *FetchScriptSettings) {
+		s.resultSetIndex = index
+	}
+}
+
+func WithFetchToken(token string) FetchScriptOption {
+	return func(s *FetchScriptSettings) {
+		s.fetchToken = token
+	}
+}
+
+func WithRowsLimit(limit int64) FetchScriptOption {
+	return func(s *FetchScriptSettings) {
+		s.rowsLimit = limit
+	}
+}
+
+func ParseFetchScriptOpts(opts ...FetchScriptOption) *FetchScriptSettings {
+	s := &FetchScriptSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+
+	return s
+}
+
+func (s *FetchScriptSettings) ResultSetIndex() int64 {
+	return s.resultSetIndex
+}
+
+func (s *FetchScriptSettings) FetchToken() string {
+	return s.fetchToken
+}
+
+func (s *FetchScriptSettings) RowsLimit() int64 {
+	return s.rowsLimit
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync/atomic"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -21,10 +24,17 @@ type result struct {
 	stream         Ydb_Query_V1.QueryService_ExecuteQueryClient
 	closeOnce      func(ctx context.Context) error
 	lastPart       *Ydb_Query.ExecuteQueryResponsePart
+	stats          *Ydb_TableStats.QueryStats
 	resultSetIndex int64
+	eof            atomic.Bool
 	errs           []error
 	closed         chan struct{}
 	trace          *trace.Query
+
+	// onFinish is called once with final error of stream (nil if stream is read to the end)
+	// or with errClosedResult if result is closed before the end of stream
+	onFinish func(err error)
+	finished atomic.Bool
 }
 
 func newResult(
@@ -72,6 +82,7 @@ func newResult(
 			stream:         stream,
 			resultSetIndex: -1,
 			lastPart:       part,
+			stats:          part.GetExecStats(),
 			closed:         closed,
 			closeOnce:      closeOnce,
 			trace:          t,
@@ -83,7 +94,7 @@ func nextPart(
 	ctx context.Context,
 	stream Ydb_Query_V1.QueryService_ExecuteQueryClient,
 	t *trace.Query,
-) (_ *Ydb_Query.ExecuteQueryResponsePart, finalErr error) {
+) (part *Ydb_Query.ExecuteQueryResponsePart, finalErr error) {
 	if t == nil {
 		t = &trace.Query{}
 	}
@@ -92,7 +103,7 @@ func nextPart(
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.nextPart"),
 	)
 	defer func() {
-		onDone(finalErr)
+		onDone(newQueryStats(part.GetExecStats()), finalErr)
 	}()
 
 	part, err := stream.Recv()
@@ -111,7 +122,55 @@ func (r *result) Close(ctx context.Context) (err error) {
 		onDone(err)
 	}()
 
-	return r.closeOnce(ctx)
+	var drainErr error
+	if r.onFinish != nil && !r.finished.Load() {
+		// final state of stream (such as outcome of inlined commit) is required,
+		// so rest of stream is read before close
+		drainErr = r.drain(ctx)
+	}
+
+	// explicit close of result overrides end of stream state
+	r.eof.Store(false)
+	r.finish(xerrors.WithStackTrace(errClosedResult))
+
+	if err = r.closeOnce(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return drainErr
+}
+
+func (r *result) drain(ctx context.Context) error {
+	for {
+		_, err := r.nextResultSet(ctx)
+		if err == nil {
+			continue
+		}
+		if xerrors.Is(err, io.EOF) {
+			return nil
+		}
+		r.finish(err)
+
+		return xerrors.WithStackTrace(err)
+	}
+}
+
+func (r *result) finish(err error) {
+	if r.finished.CompareAndSwap(false, true) && r.onFinish != nil {
+		r.onFinish(err)
+	}
+}
+
+// endOfStream handles error of stream receiving
+func (r *result) endOfStream(ctx context.Context, err error) {
+	if xerrors.Is(err, io.EOF) {
+		r.eof.Store(true)
+		_ = r.closeOnce(ctx)
+		r.finish(nil)
+
+		return
+	}
+	r.finish(err)
 }
 
 func (r *result) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
@@ -126,17 +185,25 @@ func (r *result) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
 	for {
 		select {
 		case <-r.closed:
+			if r.eof.Load() {
+				return nil, xerrors.WithStackTrace(io.EOF)
+			}
+
 			return nil, xerrors.WithStackTrace(errClosedResult)
 		case <-ctx.Done():
 			return nil, xerrors.WithStackTrace(ctx.Err())
 		default:
-			if resultSetIndex := r.lastPart.GetResultSetIndex(); resultSetIndex >= nextResultSetIndex {
+			// parts without result set (such as parts with tx meta or exec stats only) are skipped
+			if resultSetIndex := r.lastPart.GetResultSetIndex(); r.lastPart.GetResultSet() != nil &&
+				resultSetIndex >= nextResultSetIndex {
 				r.resultSetIndex = resultSetIndex
 
 				return newResultSet(r.getNextResultSetPart(ctx, nextResultSetIndex), r.lastPart, r.trace), nil
 			}
 			part, err := nextPart(ctx, r.stream, r.trace)
 			if err != nil {
+				r.endOfStream(ctx, err)
+
 				return nil, xerrors.WithStackTrace(err)
 			}
 			if part.GetResultSetIndex() < r.resultSetIndex {
@@ -145,12 +212,19 @@ func (r *result) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
 					part.GetResultSetIndex(), r.resultSetIndex, errWrongNextResultSetIndex,
 				))
 			}
-			r.lastPart = part
+			r.setLastPart(part)
 			r.resultSetIndex = part.GetResultSetIndex()
 		}
 	}
 }
 
+func (r *result) setLastPart(part *Ydb_Query.ExecuteQueryResponsePart) {
+	r.lastPart = part
+	if execStats := part.GetExecStats(); execStats != nil {
+		r.stats = execStats
+	}
+}
+
 func (r *result) getNextResultSetPart(
 	ctx context.Context,
 	nextResultSetIndex int64,
@@ -169,13 +243,11 @@ func (r *result) getNextResultSetPart(
 		default:
 			part, err := nextPart(ctx, r.stream, r.trace)
 			if err != nil {
-				if xerrors.Is(err, io.EOF) {
-					_ = r.closeOnce(ctx)
-				}
+				r.endOfStream(ctx, err)
 
 				return nil, xerrors.WithStackTrace(err)
 			}
-			r.lastPart = part
+			r.setLastPart(part)
 			if part.GetResultSetIndex() > nextResultSetIndex {
 				return nil, xerrors.WithStackTrace(fmt.Errorf(
 					"result set (index=%d) receive part (index=%d) for next result set: %w",
@@ -209,3 +281,9 @@ func (r *result) Err() error {
 		return xerrors.WithStackTrace(xerrors.Join(r.errs...))
 	}
 }
+
+// Stats returns query execution statistics received from server or nil if statistics is not received yet.
+// Statistics becomes available after reading all result sets of result
+func (r *result) Stats() stats.QueryStats {
+	return newQueryStats(r.stats)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -46,6 +47,28 @@ func newResultSet(
 	}
 }
 
+func (rs *resultSet) Index() int {
+	return int(rs.index)
+}
+
+func (rs *resultSet) Columns() []string {
+	names := make([]string, len(rs.columns))
+	for i := range rs.columns {
+		names[i] = rs.columns[i].GetName()
+	}
+
+	return names
+}
+
+func (rs *resultSet) ColumnTypes() []types.Type {
+	colTypes := make([]types.Type, len(rs.columns))
+	for i := range rs.columns {
+		colTypes[i] = types.TypeFromYDB(rs.columns[i].GetType())
+	}
+
+	return colTypes
+}
+
 func (rs *resultSet) nextRow(ctx context.Context) (*row, error) {
 	rs.rowIndex++
 	for {
@@ -13,6 +13,7 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 )
@@ -918,3 +919,41 @@ func TestResultSetNext(t *testing.T) {
 		}
 	})
 }
+
+func TestResultSetColumns(t *testing.T) {
+	rs := newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+		return nil, io.EOF
+	}, &Ydb_Query.ExecuteQueryResponsePart{
+		Status:         Ydb.StatusIds_SUCCESS,
+		ResultSetIndex: 2,
+		ResultSet: &Ydb.ResultSet{
+			Columns: []*Ydb.Column{
+				{
+					Name: "a",
+					Type: &Ydb.Type{
+						Type: &Ydb.Type_TypeId{
+							TypeId: Ydb.Type_UINT64,
+						},
+					},
+				},
+				{
+					Name: "b",
+					Type: &Ydb.Type{
+						Type: &Ydb.Type_OptionalType{
+							OptionalType: &Ydb.OptionalType{
+								Item: &Ydb.Type{
+									Type: &Ydb.Type_TypeId{
+										TypeId: Ydb.Type_UTF8,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	require.Equal(t, 2, rs.Index())
+	require.Equal(t, []string{"a", "b"}, rs.Columns())
+	require.Equal(t, []types.Type{types.Uint64, types.NewOptional(types.Text)}, rs.ColumnTypes())
+}
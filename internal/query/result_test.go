@@ -9,9 +9,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"go.uber.org/mock/gomock"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestResultNextResultSet(t *testing.T) {
@@ -893,3 +896,118 @@ func TestResultNextResultSet(t *testing.T) {
 		}, xtest.StopAfter(time.Second))
 	})
 }
+
+func TestResultStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+	stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+		Status:         Ydb.StatusIds_SUCCESS,
+		ResultSetIndex: 0,
+		ResultSet: &Ydb.ResultSet{
+			Columns: []*Ydb.Column{
+				{
+					Name: "a",
+					Type: &Ydb.Type{
+						Type: &Ydb.Type_TypeId{
+							TypeId: Ydb.Type_UINT64,
+						},
+					},
+				},
+			},
+			Rows: []*Ydb.Value{
+				{
+					Items: []*Ydb.Value{{
+						Value: &Ydb.Value_Uint64Value{
+							Uint64Value: 1,
+						},
+					}},
+				},
+			},
+		},
+	}, nil)
+	stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+		Status:         Ydb.StatusIds_SUCCESS,
+		ResultSetIndex: 0,
+		ExecStats: &Ydb_TableStats.QueryStats{
+			QueryPhases: []*Ydb_TableStats.QueryPhaseStats{
+				{
+					DurationUs: 3,
+					CpuTimeUs:  2,
+					TableAccess: []*Ydb_TableStats.TableAccessStats{
+						{
+							Name: "/local/test",
+							Reads: &Ydb_TableStats.OperationStats{
+								Rows:  1,
+								Bytes: 8,
+							},
+							PartitionsCount: 1,
+						},
+					},
+					AffectedShards: 1,
+				},
+			},
+			Compilation: &Ydb_TableStats.CompilationStats{
+				FromCache:  true,
+				DurationUs: 1,
+			},
+			QueryPlan:       "{}",
+			QueryAst:        "(return)",
+			TotalDurationUs: 10,
+			TotalCpuTimeUs:  5,
+		},
+	}, nil)
+	stream.EXPECT().Recv().Return(nil, io.EOF)
+	var traceStats []stats.QueryStats
+	r, _, err := newResult(ctx, stream, &trace.Query{
+		OnResultNextPart: func(trace.QueryResultNextPartStartInfo) func(trace.QueryResultNextPartDoneInfo) {
+			return func(info trace.QueryResultNextPartDoneInfo) {
+				if info.Stats != nil {
+					traceStats = append(traceStats, info.Stats)
+				}
+			}
+		},
+	}, nil)
+	require.NoError(t, err)
+	require.Nil(t, r.Stats())
+	rs, err := r.NextResultSet(ctx)
+	require.NoError(t, err)
+	for {
+		_, err = rs.NextRow(ctx)
+		if err != nil {
+			require.ErrorIs(t, err, io.EOF)
+
+			break
+		}
+	}
+	_, err = r.NextResultSet(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	s := r.Stats()
+	require.NotNil(t, s)
+	require.Len(t, traceStats, 1)
+	require.Equal(t, 10*time.Microsecond, s.TotalDuration())
+	require.Equal(t, 5*time.Microsecond, s.TotalCPUTime())
+	require.Equal(t, "{}", s.QueryPlan())
+	require.Equal(t, "(return)", s.QueryAST())
+	require.Equal(t, &stats.CompilationStats{
+		FromCache: true,
+		Duration:  time.Microsecond,
+	}, s.Compilation())
+	phases := s.QueryPhases()
+	require.Len(t, phases, 1)
+	require.Equal(t, 3*time.Microsecond, phases[0].Duration())
+	require.Equal(t, 2*time.Microsecond, phases[0].CPUTime())
+	require.EqualValues(t, 1, phases[0].AffectedShards())
+	require.False(t, phases[0].IsLiteralPhase())
+	require.Equal(t, []stats.TableAccess{
+		{
+			Name: "/local/test",
+			Reads: stats.OperationStats{
+				Rows:  1,
+				Bytes: 8,
+			},
+			PartitionsCount: 1,
+		},
+	}, phases[0].TableAccess())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 )
 
@@ -493,6 +494,97 @@ func TestIndexed(t *testing.T) {
 				{func(v time.Duration) *time.Duration { return &v }(time.Duration(100500000))},
 			},
 		},
+		{
+			name: "Ydb.Type_PgType(int4)",
+			s: Indexed(Data(
+				[]*Ydb.Column{
+					{
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_PgType{
+								PgType: &Ydb.PgType{
+									Oid: pg.OIDInt4,
+								},
+							},
+						},
+					},
+				},
+				[]*Ydb.Value{
+					{
+						Value: &Ydb.Value_TextValue{
+							TextValue: "123",
+						},
+					},
+				},
+			)),
+			dst: [][]interface{}{
+				{func(v int32) *int32 { return &v }(0)},
+				{func(v int64) *int64 { return &v }(0)},
+				{func(v string) *string { return &v }("")},
+				{func(v *int32) **int32 { return &v }(nil)},
+			},
+			exp: [][]interface{}{
+				{func(v int32) *int32 { return &v }(123)},
+				{func(v int64) *int64 { return &v }(123)},
+				{func(v string) *string { return &v }("123")},
+				{func(v int32) **int32 { vv := &v; return &vv }(123)},
+			},
+		},
+		{
+			name: "Ydb.Type_PgType(timestamptz)",
+			s: Indexed(Data(
+				[]*Ydb.Column{
+					{
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_PgType{
+								PgType: &Ydb.PgType{
+									Oid: pg.OIDTimestamptz,
+								},
+							},
+						},
+					},
+				},
+				[]*Ydb.Value{
+					{
+						Value: &Ydb.Value_TextValue{
+							TextValue: "2024-01-02 03:04:05Z",
+						},
+					},
+				},
+			)),
+			dst: [][]interface{}{
+				{func(v time.Time) *time.Time { return &v }(time.Time{})},
+			},
+			exp: [][]interface{}{
+				{func(v time.Time) *time.Time { return &v }(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+			},
+		},
+		{
+			name: "Ydb.Type_PgType(text) NULL",
+			s: Indexed(Data(
+				[]*Ydb.Column{
+					{
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_PgType{
+								PgType: &Ydb.PgType{
+									Oid: pg.OIDText,
+								},
+							},
+						},
+					},
+				},
+				[]*Ydb.Value{
+					{
+						Value: &Ydb.Value_NullFlagValue{},
+					},
+				},
+			)),
+			dst: [][]interface{}{
+				{func(v *string) **string { return &v }(func(v string) *string { return &v }("test"))},
+			},
+			exp: [][]interface{}{
+				{func(v *string) **string { return &v }(nil)},
+			},
+		},
 	} {
 		for i := range tt.dst {
 			t.Run(tt.name+"→"+reflect.TypeOf(tt.dst[i][0]).Elem().String(), func(t *testing.T) {
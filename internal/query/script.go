@@ -0,0 +1,219 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func (c *Client) ExecuteScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.ExecuteOption,
+) (*query.ExecuteScriptOperation, error) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+		a := allocator.New()
+		defer a.Free()
+
+		settings := options.ExecuteSettings(opts...)
+		request := &Ydb_Query.ExecuteScriptRequest{
+			OperationParams: operation.Params(ctx,
+				c.config.OperationTimeout(),
+				c.config.OperationCancelAfter(),
+				operation.ModeAsync,
+			),
+			ExecMode:      Ydb_Query.ExecMode(settings.ExecMode()),
+			ScriptContent: queryFromText(a, q, Ydb_Query.Syntax(settings.Syntax())).QueryContent,
+			Parameters:    settings.Params().ToYDB(a),
+			StatsMode:     Ydb_Query.StatsMode(settings.StatsMode()),
+			ResultsTtl:    durationpb.New(ttl),
+		}
+
+		// script operation is not ready on start, so response must not be checked on conn layer
+		op, err := c.grpcClient.ExecuteScript(conn.WithoutWrapping(ctx), request, settings.CallOptions()...)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		scriptOperation, err := executeScriptOperationFromYDB(operation.FromYDB(op))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return scriptOperation, nil
+	}
+}
+
+func executeScriptOperationFromYDB(op *operation.Operation) (*query.ExecuteScriptOperation, error) {
+	if err := op.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	scriptOperation := &query.ExecuteScriptOperation{
+		ID:            op.ID,
+		Ready:         op.Ready,
+		ConsumedUnits: op.ConsumedUnits,
+	}
+
+	if op.Metadata == nil {
+		return scriptOperation, nil
+	}
+
+	var metadata Ydb_Query.ExecuteScriptMetadata
+	if err := op.Metadata.UnmarshalTo(&metadata); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	scriptOperation.Metadata = &query.ScriptMetadata{
+		ExecutionID: metadata.GetExecutionId(),
+		Status:      options.ExecStatus(metadata.GetExecStatus()),
+		Script:      metadata.GetScriptContent().GetText(),
+		ExecMode:    options.ExecMode(metadata.GetExecMode()),
+		Stats:       newQueryStats(metadata.GetExecStats()),
+	}
+	for _, meta := range metadata.GetResultSetsMeta() {
+		resultSetMeta := query.ScriptResultSetMeta{
+			Columns:     make([]string, 0, len(meta.GetColumns())),
+			ColumnTypes: make([]types.Type, 0, len(meta.GetColumns())),
+		}
+		for _, column := range meta.GetColumns() {
+			resultSetMeta.Columns = append(resultSetMeta.Columns, column.GetName())
+			resultSetMeta.ColumnTypes = append(resultSetMeta.ColumnTypes, types.TypeFromYDB(column.GetType()))
+		}
+		scriptOperation.Metadata.ResultSetsMeta = append(scriptOperation.Metadata.ResultSetsMeta, resultSetMeta)
+	}
+
+	return scriptOperation, nil
+}
+
+func (c *Client) FetchScriptResults(
+	ctx context.Context, opID string, opts ...options.FetchScriptOption,
+) (*query.FetchScriptResult, error) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+		var (
+			settings = options.ParseFetchScriptOpts(opts...)
+			result   *query.FetchScriptResult
+		)
+		err := retry.Retry(ctx, func(ctx context.Context) (err error) {
+			result, err = c.fetchScriptResults(ctx, opID, settings)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			return nil
+		},
+			retry.WithStackTrace(),
+			retry.WithIdempotent(true),
+			retry.WithTrace(c.config.TraceRetry()),
+			retry.WithBudget(c.config.RetryBudget()),
+		)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return result, nil
+	}
+}
+
+func (c *Client) fetchScriptResults(
+	ctx context.Context, opID string, settings *options.FetchScriptSettings,
+) (*query.FetchScriptResult, error) {
+	response, err := c.grpcClient.FetchScriptResults(ctx, &Ydb_Query.FetchScriptResultsRequest{
+		OperationId:    opID,
+		ResultSetIndex: settings.ResultSetIndex(),
+		FetchToken:     settings.FetchToken(),
+		RowsLimit:      settings.RowsLimit(),
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+
+	rs := &materializedResultSet{
+		index:       int(response.GetResultSetIndex()),
+		columns:     make([]string, 0, len(response.GetResultSet().GetColumns())),
+		columnTypes: make([]types.Type, 0, len(response.GetResultSet().GetColumns())),
+		rows:        make([]query.Row, 0, len(response.GetResultSet().GetRows())),
+	}
+	for _, column := range response.GetResultSet().GetColumns() {
+		rs.columns = append(rs.columns, column.GetName())
+		rs.columnTypes = append(rs.columnTypes, types.TypeFromYDB(column.GetType()))
+	}
+	for _, v := range response.GetResultSet().GetRows() {
+		r, err := newRow(ctx, response.GetResultSet().GetColumns(), v, c.config.Trace())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		rs.rows = append(rs.rows, r)
+	}
+
+	return &query.FetchScriptResult{
+		ResultSetIndex: response.GetResultSetIndex(),
+		ResultSet:      rs,
+		NextToken:      response.GetNextFetchToken(),
+	}, nil
+}
+
+func (c *Client) GetScriptOperation(ctx context.Context, opID string) (*query.ExecuteScriptOperation, error) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+		op, err := c.operationClient.Get(ctx, opID)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		scriptOperation, err := executeScriptOperationFromYDB(op)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return scriptOperation, nil
+	}
+}
+
+func (c *Client) CancelScriptOperation(ctx context.Context, opID string) error {
+	select {
+	case <-c.done:
+		return xerrors.WithStackTrace(errClosedClient)
+	default:
+		if err := c.operationClient.Cancel(ctx, opID); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}
+}
+
+func (c *Client) ForgetScriptOperation(ctx context.Context, opID string) error {
+	select {
+	case <-c.done:
+		return xerrors.WithStackTrace(errClosedClient)
+	default:
+		if err := c.operationClient.Forget(ctx, opID); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}
+}
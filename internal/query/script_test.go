@@ -0,0 +1,149 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestClientExecuteScript(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("HappyWay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		metadata, err := anypb.New(&Ydb_Query.ExecuteScriptMetadata{
+			ExecutionId: "123",
+			ExecStatus:  Ydb_Query.ExecStatus_EXEC_STATUS_STARTING,
+			ScriptContent: &Ydb_Query.QueryContent{
+				Syntax: Ydb_Query.Syntax_SYNTAX_YQL_V1,
+				Text:   "SELECT 1 AS a",
+			},
+			ResultSetsMeta: []*Ydb_Query.ResultSetMeta{{
+				Columns: []*Ydb.Column{{
+					Name: "a",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}},
+				}},
+			}},
+			ExecMode: Ydb_Query.ExecMode_EXEC_MODE_EXECUTE,
+		})
+		require.NoError(t, err)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteScript(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, request *Ydb_Query.ExecuteScriptRequest, _ ...interface{}) (
+				*Ydb_Operations.Operation, error,
+			) {
+				require.Equal(t, "SELECT 1 AS a", request.GetScriptContent().GetText())
+				require.Equal(t, time.Hour, request.GetResultsTtl().AsDuration())
+
+				return &Ydb_Operations.Operation{
+					Id:       "test-operation",
+					Ready:    false,
+					Status:   Ydb.StatusIds_SUCCESS,
+					Metadata: metadata,
+				}, nil
+			},
+		)
+		c := &Client{
+			config:     config.New(),
+			grpcClient: client,
+			done:       make(chan struct{}),
+		}
+		op, err := c.ExecuteScript(ctx, "SELECT 1 AS a", time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, "test-operation", op.ID)
+		require.False(t, op.Ready)
+		require.NotNil(t, op.Metadata)
+		require.Equal(t, "123", op.Metadata.ExecutionID)
+		require.Equal(t, options.ExecStatusStarting, op.Metadata.Status)
+		require.Equal(t, options.ExecModeExecute, op.Metadata.ExecMode)
+		require.Equal(t, "SELECT 1 AS a", op.Metadata.Script)
+		require.Len(t, op.Metadata.ResultSetsMeta, 1)
+		require.Equal(t, []string{"a"}, op.Metadata.ResultSetsMeta[0].Columns)
+		require.Equal(t, []types.Type{types.Int32}, op.Metadata.ResultSetsMeta[0].ColumnTypes)
+	})
+	t.Run("OperationError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteScript(gomock.Any(), gomock.Any()).Return(&Ydb_Operations.Operation{
+			Id:     "test-operation",
+			Ready:  true,
+			Status: Ydb.StatusIds_BAD_REQUEST,
+		}, nil)
+		c := &Client{
+			config:     config.New(),
+			grpcClient: client,
+			done:       make(chan struct{}),
+		}
+		_, err := c.ExecuteScript(ctx, "SELECT", time.Hour)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+	})
+}
+
+func TestClientFetchScriptResults(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	client := NewMockQueryServiceClient(ctrl)
+	client.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *Ydb_Query.FetchScriptResultsRequest, _ ...interface{}) (
+			*Ydb_Query.FetchScriptResultsResponse, error,
+		) {
+			require.Equal(t, "test-operation", request.GetOperationId())
+			require.EqualValues(t, 1, request.GetResultSetIndex())
+			require.Equal(t, "token", request.GetFetchToken())
+			require.EqualValues(t, 2, request.GetRowsLimit())
+
+			return &Ydb_Query.FetchScriptResultsResponse{
+				Status:         Ydb.StatusIds_SUCCESS,
+				ResultSetIndex: 1,
+				ResultSet: &Ydb.ResultSet{
+					Columns: []*Ydb.Column{{
+						Name: "a",
+						Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+					}},
+					Rows: []*Ydb.Value{
+						{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}}}},
+						{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}}}},
+					},
+				},
+				NextFetchToken: "next-token",
+			}, nil
+		},
+	)
+	c := &Client{
+		config:     config.New(),
+		grpcClient: client,
+		done:       make(chan struct{}),
+	}
+	result, err := c.FetchScriptResults(ctx, "test-operation",
+		options.WithResultSetIndex(1),
+		options.WithFetchToken("token"),
+		options.WithRowsLimit(2),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.ResultSetIndex)
+	require.Equal(t, "next-token", result.NextToken)
+	require.Equal(t, 1, result.ResultSet.Index())
+	require.Equal(t, []string{"a"}, result.ResultSet.Columns())
+	var values []uint64
+	for {
+		row, err := result.ResultSet.NextRow(ctx)
+		if err != nil {
+			break
+		}
+		var v uint64
+		require.NoError(t, row.Scan(&v))
+		values = append(values, v)
+	}
+	require.Equal(t, []uint64{1, 2}, values)
+}
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
+)
+
+var (
+	_ stats.QueryStats = (*queryStats)(nil)
+	_ stats.QueryPhase = (*queryPhase)(nil)
+)
+
+type (
+	// queryStats wraps query execution statistics from ExecuteQueryResponsePart
+	queryStats struct {
+		pb *Ydb_TableStats.QueryStats
+	}
+	// queryPhase wraps query execution phase statistics
+	queryPhase struct {
+		pb *Ydb_TableStats.QueryPhaseStats
+	}
+)
+
+// newQueryStats returns nil stats.QueryStats if pb is nil
+func newQueryStats(pb *Ydb_TableStats.QueryStats) stats.QueryStats {
+	if pb == nil {
+		return nil
+	}
+
+	return &queryStats{pb: pb}
+}
+
+func (s *queryStats) ProcessCPUTime() time.Duration {
+	return time.Microsecond * time.Duration(s.pb.GetProcessCpuTimeUs())
+}
+
+func (s *queryStats) Compilation() *stats.CompilationStats {
+	if s.pb.GetCompilation() == nil {
+		return nil
+	}
+
+	return &stats.CompilationStats{
+		FromCache: s.pb.GetCompilation().GetFromCache(),
+		Duration:  time.Microsecond * time.Duration(s.pb.GetCompilation().GetDurationUs()),
+		CPUTime:   time.Microsecond * time.Duration(s.pb.GetCompilation().GetCpuTimeUs()),
+	}
+}
+
+func (s *queryStats) QueryPlan() string {
+	return s.pb.GetQueryPlan()
+}
+
+func (s *queryStats) QueryAST() string {
+	return s.pb.GetQueryAst()
+}
+
+func (s *queryStats) TotalCPUTime() time.Duration {
+	return time.Microsecond * time.Duration(s.pb.GetTotalCpuTimeUs())
+}
+
+func (s *queryStats) TotalDuration() time.Duration {
+	return time.Microsecond * time.Duration(s.pb.GetTotalDurationUs())
+}
+
+func (s *queryStats) QueryPhases() []stats.QueryPhase {
+	phases := make([]stats.QueryPhase, 0, len(s.pb.GetQueryPhases()))
+	for _, phase := range s.pb.GetQueryPhases() {
+		if phase == nil {
+			continue
+		}
+		phases = append(phases, &queryPhase{pb: phase})
+	}
+
+	return phases
+}
+
+func (p *queryPhase) Duration() time.Duration {
+	return time.Microsecond * time.Duration(p.pb.GetDurationUs())
+}
+
+func (p *queryPhase) CPUTime() time.Duration {
+	return time.Microsecond * time.Duration(p.pb.GetCpuTimeUs())
+}
+
+func (p *queryPhase) AffectedShards() uint64 {
+	return p.pb.GetAffectedShards()
+}
+
+func (p *queryPhase) IsLiteralPhase() bool {
+	return p.pb.GetLiteralPhase()
+}
+
+func (p *queryPhase) TableAccess() []stats.TableAccess {
+	tables := make([]stats.TableAccess, 0, len(p.pb.GetTableAccess()))
+	for _, table := range p.pb.GetTableAccess() {
+		tables = append(tables, stats.TableAccess{
+			Name:            table.GetName(),
+			Reads:           operationStats(table.GetReads()),
+			Updates:         operationStats(table.GetUpdates()),
+			Deletes:         operationStats(table.GetDeletes()),
+			PartitionsCount: table.GetPartitionsCount(),
+		})
+	}
+
+	return tables
+}
+
+func operationStats(pb *Ydb_TableStats.OperationStats) stats.OperationStats {
+	return stats.OperationStats{
+		Rows:  pb.GetRows(),
+		Bytes: pb.GetBytes(),
+	}
+}
@@ -2,22 +2,53 @@ package query
 
 import (
 	"context"
+	"sync"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	internalTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
-var _ query.Transaction = (*transaction)(nil)
+var (
+	_ query.Transaction      = (*transaction)(nil)
+	_ internalTx.Transaction = (*transaction)(nil)
+)
 
 type transaction struct {
+	s *Session
+
+	// txSettings are settings of lazy transaction which begins with first execute
+	txSettings query.TransactionSettings
+
+	// beginM serializes lazy begin of transaction: concurrent executes wait while
+	// the first one begins transaction
+	beginM sync.Mutex
+
+	// m guards fields below
+	m  xsync.Mutex
 	id string
-	s  *Session
+	// commitStarted is true if commit of transaction is requested (with execute or separated call),
+	// transaction does not accept queries after that
+	commitStarted bool
+	// commitResult is result of execute with inlined commit, outcome of commit is known
+	// after the result is read to the end
+	commitResult *result
+	// commitDone is true if outcome of commit is known, commitErr is nil if transaction committed
+	commitDone bool
+	commitErr  error
+
+	// onBeforeCommit and onCompleted are hooks of other services (topic writers and readers)
+	// which operations are bound to the transaction
+	onBeforeCommit []internalTx.OnBeforeCommitFunc
+	onCompleted    []internalTx.OnCompletedFunc
+	completed      bool
 }
 
 func newTransaction(id string, s *Session) *transaction {
@@ -27,24 +58,220 @@ func newTransaction(id string, s *Session) *transaction {
 	}
 }
 
-func (tx transaction) ID() string {
+// newLazyTransaction makes transaction which begins with first execute (inlined BeginTx in TransactionControl)
+// instead of separated BeginTransaction call
+func newLazyTransaction(s *Session, txSettings query.TransactionSettings) *transaction {
+	return &transaction{
+		s:          s,
+		txSettings: txSettings,
+	}
+}
+
+func (tx *transaction) ID() string {
+	tx.m.Lock()
+	defer tx.m.Unlock()
+
 	return tx.id
 }
 
-func (tx transaction) Execute(ctx context.Context, q string, opts ...options.TxExecuteOption) (
+func (tx *transaction) SessionID() string {
+	return tx.s.id
+}
+
+// UnLazy begins lazy transaction with separated BeginTransaction call if transaction is not begun yet
+func (tx *transaction) UnLazy(ctx context.Context) error {
+	tx.beginM.Lock()
+	defer tx.beginM.Unlock()
+
+	id, commitStarted := tx.state()
+	if commitStarted {
+		return xerrors.WithStackTrace(errTxAlreadyCommitted)
+	}
+
+	if id != "" {
+		return nil
+	}
+
+	t, err := begin(ctx, tx.s.grpcClient, tx.s, tx.txSettings)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	tx.m.WithLock(func() {
+		tx.id = t.id
+	})
+
+	return nil
+}
+
+func (tx *transaction) state() (id string, commitStarted bool) {
+	tx.m.Lock()
+	defer tx.m.Unlock()
+
+	return tx.id, tx.commitStarted
+}
+
+// startCommit marks transaction as committing, it returns false if commit is already started
+func (tx *transaction) startCommit() bool {
+	tx.m.Lock()
+	defer tx.m.Unlock()
+
+	if tx.commitStarted {
+		return false
+	}
+	tx.commitStarted = true
+
+	return true
+}
+
+// finishCommit stores outcome of commit and notifies hooks about completion of transaction
+func (tx *transaction) finishCommit(err error) {
+	tx.m.WithLock(func() {
+		tx.commitDone = true
+		tx.commitErr = err
+	})
+	tx.notifyCompleted(err)
+}
+
+// waitCommit waits outcome of started commit and returns nil if transaction is committed
+func (tx *transaction) waitCommit(ctx context.Context) error {
+	var commitResult *result
+	tx.m.WithLock(func() {
+		commitResult = tx.commitResult
+	})
+
+	if commitResult != nil {
+		// outcome of inlined commit is known after the rest of execute result is read
+		_ = commitResult.Close(ctx)
+	}
+
+	tx.m.Lock()
+	defer tx.m.Unlock()
+
+	if !tx.commitDone {
+		return xerrors.WithStackTrace(errTxAlreadyCommitted)
+	}
+
+	return tx.commitErr
+}
+
+func (tx *transaction) OnBeforeCommit(f internalTx.OnBeforeCommitFunc) {
+	tx.m.WithLock(func() {
+		tx.onBeforeCommit = append(tx.onBeforeCommit, f)
+	})
+}
+
+func (tx *transaction) OnCompleted(f internalTx.OnCompletedFunc) {
+	tx.m.WithLock(func() {
+		tx.onCompleted = append(tx.onCompleted, f)
+	})
+}
+
+func (tx *transaction) beforeCommit(ctx context.Context) error {
+	var hooks []internalTx.OnBeforeCommitFunc
+	tx.m.WithLock(func() {
+		hooks = tx.onBeforeCommit
+	})
+
+	for _, f := range hooks {
+		if err := f(ctx); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func (tx *transaction) notifyCompleted(err error) {
+	var hooks []internalTx.OnCompletedFunc
+	tx.m.WithLock(func() {
+		if tx.completed {
+			return
+		}
+		tx.completed = true
+		hooks = tx.onCompleted
+	})
+
+	for _, f := range hooks {
+		f(err)
+	}
+}
+
+func (tx *transaction) Execute(ctx context.Context, q string, opts ...options.TxExecuteOption) (
 	r query.Result, finalErr error,
 ) {
+	// lazy begin is serialized: concurrent executes wait while the first one begins transaction
+	tx.beginM.Lock()
+	id, commitStarted := tx.state()
+	if id != "" {
+		tx.beginM.Unlock()
+	} else {
+		defer tx.beginM.Unlock()
+	}
+
+	settings := options.TxExecuteSettings(id, opts...)
+	inlineBegin, inlineCommit := id == "", settings.CommitTx()
+	switch {
+	case inlineBegin && inlineCommit:
+		settings.ExecuteSettings.SetTxControl(query.TxControl(query.BeginTx(tx.txSettings...), query.CommitTx()))
+	case inlineBegin:
+		settings.ExecuteSettings.SetTxControl(query.TxControl(query.BeginTx(tx.txSettings...)))
+	case inlineCommit:
+		settings.ExecuteSettings.SetTxControl(query.TxControl(query.WithTxID(id), query.CommitTx()))
+	}
+
 	onDone := trace.QueryOnTxExecute(tx.s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.transaction.Execute"), tx.s, tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.(*transaction).Execute"),
+		tx.s, tx, q, inlineBegin, inlineCommit,
+	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
-	_, res, err := execute(ctx, tx.s, tx.s.grpcClient, q, options.TxExecuteSettings(tx.id, opts...).ExecuteSettings)
+	if commitStarted {
+		return nil, xerrors.WithStackTrace(errTxAlreadyCommitted)
+	}
+
+	if inlineCommit {
+		if err := tx.beforeCommit(ctx); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if !tx.startCommit() {
+			return nil, xerrors.WithStackTrace(errTxAlreadyCommitted)
+		}
+	}
+
+	t, res, err := execute(ctx, tx.s, tx.s.grpcClient, q, settings.ExecuteSettings)
 	if err != nil {
+		if inlineCommit {
+			tx.finishCommit(err)
+		}
+
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	if inlineBegin {
+		switch {
+		case t != nil:
+			tx.m.WithLock(func() {
+				tx.id = t.id
+			})
+		case !inlineCommit:
+			_ = res.Close(ctx)
+
+			return nil, xerrors.WithStackTrace(errNoTxID)
+		}
+	}
+
+	if inlineCommit {
+		// transaction is committed only if the rest of stream is received without errors,
+		// so hooks are notified when the stream is finished or the result is closed
+		res.onFinish = tx.finishCommit
+		tx.m.WithLock(func() {
+			tx.commitResult = res
+		})
+	}
+
 	return res, nil
 }
 
@@ -60,8 +287,35 @@ func commitTx(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessi
 	return nil
 }
 
-func (tx transaction) CommitTx(ctx context.Context) (err error) {
-	return commitTx(ctx, tx.s.grpcClient, tx.s.id, tx.id)
+func (tx *transaction) CommitTx(ctx context.Context) (err error) {
+	id, commitStarted := tx.state()
+	if commitStarted {
+		// transaction already committed with execute
+		return tx.waitCommit(ctx)
+	}
+
+	if id == "" {
+		// lazy transaction was not begun, nothing to commit
+		tx.notifyCompleted(nil)
+
+		return nil
+	}
+
+	if err = tx.beforeCommit(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if !tx.startCommit() {
+		return tx.waitCommit(ctx)
+	}
+
+	err = commitTx(ctx, tx.s.grpcClient, tx.s.id, id)
+	tx.finishCommit(err)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
 }
 
 func rollback(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessionID, txID string) error {
@@ -76,6 +330,27 @@ func rollback(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessi
 	return nil
 }
 
-func (tx transaction) Rollback(ctx context.Context) (err error) {
-	return rollback(ctx, tx.s.grpcClient, tx.s.id, tx.id)
+func (tx *transaction) Rollback(ctx context.Context) (err error) {
+	id, commitStarted := tx.state()
+	if commitStarted && tx.waitCommit(ctx) == nil {
+		// transaction already committed
+		return nil
+	}
+
+	if id == "" {
+		// lazy transaction was not begun, nothing to rollback
+		tx.notifyCompleted(xerrors.WithStackTrace(errTxRollbacked))
+
+		return nil
+	}
+
+	if err = rollback(ctx, tx.s.grpcClient, tx.s.id, id); err != nil {
+		tx.notifyCompleted(err)
+
+		return xerrors.WithStackTrace(err)
+	}
+
+	tx.notifyCompleted(xerrors.WithStackTrace(errTxRollbacked))
+
+	return nil
 }
@@ -1,9 +1,14 @@
 package query
 
 import (
+	"context"
+	"errors"
+	"io"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"go.uber.org/mock/gomock"
@@ -14,6 +19,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	internalTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -134,6 +140,232 @@ func (s testExecuteSettings) CallOptions() []grpc.CallOption {
 
 var _ executeConfig = testExecuteSettings{}
 
+type testTxIdentifier string
+
+func (id testTxIdentifier) ID() string {
+	return string(id)
+}
+
+func TestTransactionHooks(t *testing.T) {
+	t.Run("Commit", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(
+			&Ydb_Query.BeginTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+				TxMeta: &Ydb_Query.TransactionMeta{Id: "456"},
+			}, nil,
+		)
+		service.EXPECT().CommitTransaction(gomock.Any(), &Ydb_Query.CommitTransactionRequest{
+			SessionId: "123",
+			TxId:      "456",
+		}).Return(
+			&Ydb_Query.CommitTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil,
+		)
+		tx := newLazyTransaction(newTestSessionWithClient("123", service), nil)
+		var calls []string
+		tx.OnBeforeCommit(func(ctx context.Context) error {
+			calls = append(calls, "before commit")
+
+			return nil
+		})
+		tx.OnCompleted(func(err error) {
+			require.NoError(t, err)
+			calls = append(calls, "completed")
+		})
+		require.NoError(t, tx.UnLazy(ctx))
+		require.Equal(t, "456", tx.ID())
+		require.Equal(t, "123", tx.SessionID())
+		require.NoError(t, tx.UnLazy(ctx))
+		require.NoError(t, tx.CommitTx(ctx))
+		require.Equal(t, []string{"before commit", "completed"}, calls)
+	})
+	t.Run("BeforeCommitFailed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(
+			&Ydb_Query.RollbackTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil,
+		)
+		tx := newTransaction("456", newTestSessionWithClient("123", service))
+		testErr := errors.New("test error")
+		var completedErr error
+		tx.OnBeforeCommit(func(ctx context.Context) error {
+			return testErr
+		})
+		tx.OnCompleted(func(err error) {
+			completedErr = err
+		})
+		require.ErrorIs(t, tx.CommitTx(ctx), testErr)
+		require.NoError(t, completedErr)
+		require.NoError(t, tx.Rollback(ctx))
+		require.ErrorIs(t, completedErr, errTxRollbacked)
+	})
+	t.Run("InlinedCommit", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		testErr := errors.New("test error")
+		service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(nil, testErr)
+		tx := newTransaction("456", newTestSessionWithClient("123", service))
+		var calls []string
+		tx.OnBeforeCommit(func(ctx context.Context) error {
+			calls = append(calls, "before commit")
+
+			return nil
+		})
+		tx.OnCompleted(func(err error) {
+			require.ErrorIs(t, err, testErr)
+			calls = append(calls, "completed")
+		})
+		_, err := tx.Execute(ctx, "SELECT 1", options.WithCommit())
+		require.ErrorIs(t, err, testErr)
+		require.Equal(t, []string{"before commit", "completed"}, calls)
+	})
+	t.Run("InlinedCommitStream", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		testErr := errors.New("test error")
+		service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(newTestExecuteStream(ctrl, testErr), nil)
+		tx := newTransaction("456", newTestSessionWithClient("123", service))
+		var (
+			completed    bool
+			completedErr error
+		)
+		tx.OnCompleted(func(err error) {
+			completed, completedErr = true, err
+		})
+		res, err := tx.Execute(ctx, "SELECT 1", options.WithCommit())
+		require.NoError(t, err)
+		require.False(t, completed)
+		_, err = res.NextResultSet(ctx)
+		require.ErrorIs(t, err, testErr)
+		require.True(t, completed)
+		require.ErrorIs(t, completedErr, testErr)
+	})
+	t.Run("RollbackFailed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		var completed bool
+		service.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.RollbackTransactionRequest, opts ...grpc.CallOption) (
+				*Ydb_Query.RollbackTransactionResponse, error,
+			) {
+				require.False(t, completed)
+
+				return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+			},
+		)
+		tx := newTransaction("456", newTestSessionWithClient("123", service))
+		var completedErr error
+		tx.OnCompleted(func(err error) {
+			completed, completedErr = true, err
+		})
+		require.Error(t, tx.Rollback(ctx))
+		require.True(t, completed)
+		require.True(t, xerrors.IsOperationError(completedErr, Ydb.StatusIds_UNAVAILABLE))
+	})
+	t.Run("AsTransaction", func(t *testing.T) {
+		_, err := internalTx.AsTransaction(newTransaction("456", newTestSession("123")))
+		require.NoError(t, err)
+		_, err = internalTx.AsTransaction(testTxIdentifier("456"))
+		require.Error(t, err)
+	})
+}
+
+func newTestExecuteStream(ctrl *gomock.Controller, recvErr error) *MockQueryService_ExecuteQueryClient {
+	stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+	stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+		Status: Ydb.StatusIds_SUCCESS,
+		TxMeta: &Ydb_Query.TransactionMeta{
+			Id: "456",
+		},
+	}, nil)
+	stream.EXPECT().Recv().Return(nil, recvErr).AnyTimes()
+
+	return stream
+}
+
+func TestTransactionLazyBegin(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	service := NewMockQueryServiceClient(ctrl)
+	var (
+		m      sync.Mutex
+		begins int
+	)
+	service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			if in.GetTxControl().GetBeginTx() != nil {
+				m.Lock()
+				begins++
+				m.Unlock()
+			} else {
+				require.Equal(t, "456", in.GetTxControl().GetTxId())
+			}
+
+			return newTestExecuteStream(ctrl, io.EOF), nil
+		},
+	).Times(10)
+	tx := newLazyTransaction(newTestSessionWithClient("123", service), nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := tx.Execute(ctx, "SELECT 1")
+			require.NoError(t, err)
+			require.NoError(t, res.Close(ctx))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, begins)
+	require.Equal(t, "456", tx.ID())
+}
+
+func TestTransactionInlinedCommitResult(t *testing.T) {
+	t.Run("Committed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(newTestExecuteStream(ctrl, io.EOF), nil)
+		tx := newTransaction("456", newTestSessionWithClient("123", service))
+		_, err := tx.Execute(ctx, "SELECT 1", options.WithCommit())
+		require.NoError(t, err)
+		require.NoError(t, tx.CommitTx(ctx))
+		require.NoError(t, tx.Rollback(ctx))
+	})
+	t.Run("StreamFailed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		testErr := errors.New("test error")
+		service.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(newTestExecuteStream(ctrl, testErr), nil)
+		service.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(
+			&Ydb_Query.RollbackTransactionResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil,
+		)
+		tx := newTransaction("456", newTestSessionWithClient("123", service))
+		res, err := tx.Execute(ctx, "SELECT 1", options.WithCommit())
+		require.NoError(t, err)
+		_, err = tx.Execute(ctx, "SELECT 2")
+		require.ErrorIs(t, err, errTxAlreadyCommitted)
+		require.ErrorIs(t, res.Close(ctx), testErr)
+		require.ErrorIs(t, tx.CommitTx(ctx), testErr)
+		require.NoError(t, tx.Rollback(ctx))
+	})
+}
+
 func TestTxExecuteSettings(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -0,0 +1,49 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errUnsupportedTransaction = errors.New("transaction can't be used for bind operations of other services")
+
+type (
+	// OnBeforeCommitFunc is a callback which called before commit of transaction.
+	// Error from callback cancels commit of transaction
+	OnBeforeCommitFunc func(ctx context.Context) error
+
+	// OnCompletedFunc is a callback which called after commit or rollback of transaction.
+	// Argument is nil if transaction successfully committed
+	OnCompletedFunc func(err error)
+
+	// Transaction is an interactive transaction of query service which can be used
+	// for bind operations of other services (for example, topic writes and reads) to the transaction
+	Transaction interface {
+		Identifier
+
+		// SessionID returns ID of session of the transaction
+		SessionID() string
+
+		// UnLazy begins lazy transaction if it is not begun yet.
+		// After UnLazy transaction ID is not empty
+		UnLazy(ctx context.Context) error
+
+		// OnBeforeCommit adds callback which will be called before commit of transaction
+		OnBeforeCommit(f OnBeforeCommitFunc)
+
+		// OnCompleted adds callback which will be called after commit or rollback of transaction
+		OnCompleted(f OnCompletedFunc)
+	}
+)
+
+// AsTransaction checks that transaction can be used for bind operations of other services to the transaction
+func AsTransaction(t Identifier) (Transaction, error) {
+	if tx, ok := t.(Transaction); ok {
+		return tx, nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errUnsupportedTransaction, t))
+}
@@ -404,3 +404,13 @@ func (c *Client) acquireResource(
 
 	return xerrors.WithStackTrace(err)
 }
+
+func (c *Client) Limiter(
+	coordinationNodePath string,
+	resourcePath string,
+	opts ...ratelimiter.LimiterOption,
+) *ratelimiter.Limiter {
+	return ratelimiter.NewLimiter(c, coordinationNodePath, resourcePath,
+		append([]ratelimiter.LimiterOption{ratelimiter.WithLimiterTrace(*c.config.Trace())}, opts...)...,
+	)
+}
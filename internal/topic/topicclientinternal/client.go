@@ -9,10 +9,13 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topiclistener"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
@@ -94,6 +97,39 @@ func (c *Client) Alter(ctx context.Context, path string, opts ...topicoptions.Al
 	return call(ctx)
 }
 
+// CommitOffset commits offset of partition for the consumer without active reader
+func (c *Client) CommitOffset(
+	ctx context.Context,
+	path string,
+	partitionID int64,
+	consumer string,
+	offset int64,
+) error {
+	req := &rawtopic.CommitOffsetRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+		PartitionID:     partitionID,
+		Consumer:        consumer,
+		Offset:          offset,
+	}
+
+	call := func(ctx context.Context) error {
+		_, commitErr := c.rawClient.CommitOffset(ctx, req)
+
+		return commitErr
+	}
+
+	if c.cfg.AutoRetry() {
+		return retry.Retry(ctx, call,
+			retry.WithIdempotent(true),
+			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+		)
+	}
+
+	return call(ctx)
+}
+
 // Create new topic
 func (c *Client) Create(
 	ctx context.Context,
@@ -173,6 +209,54 @@ func (c *Client) Describe(
 	return res, nil
 }
 
+// DescribeTopicConsumer describe consumer of topic
+func (c *Client) DescribeTopicConsumer(
+	ctx context.Context,
+	path string,
+	consumer string,
+	opts ...topicoptions.DescribeConsumerOption,
+) (res topictypes.TopicConsumerDescription, _ error) {
+	req := rawtopic.DescribeConsumerRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+		Consumer:        consumer,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&req)
+		}
+	}
+
+	var rawRes rawtopic.DescribeConsumerResult
+
+	call := func(ctx context.Context) (describeErr error) {
+		rawRes, describeErr = c.rawClient.DescribeConsumer(ctx, req)
+
+		return describeErr
+	}
+
+	var err error
+
+	if c.cfg.AutoRetry() {
+		err = retry.Retry(ctx, call,
+			retry.WithIdempotent(true),
+			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+		)
+	} else {
+		err = call(ctx)
+	}
+
+	if err != nil {
+		return res, err
+	}
+
+	res.FromRaw(&rawRes)
+
+	return res, nil
+}
+
 // Drop topic
 func (c *Client) Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error {
 	req := rawtopic.DropTopicRequest{}
@@ -209,31 +293,95 @@ func (c *Client) StartReader(
 	readSelectors topicoptions.ReadSelectors,
 	opts ...topicoptions.ReaderOption,
 ) (*topicreader.Reader, error) {
-	var connector topicreaderinternal.TopicSteamReaderConnect = func(ctx context.Context) (
-		topicreaderinternal.RawTopicReaderStream, error,
-	) {
+	internalReader, err := topicreaderinternal.NewReader(
+		c.readerConnector(), consumer, readSelectors, c.readerOptions(opts...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	trace.TopicOnReaderStart(internalReader.Tracer(), internalReader.ID(), consumer, err)
+
+	return topicreader.NewReader(internalReader), nil
+}
+
+// StartListener create new topic listener which push read messages to handler
+func (c *Client) StartListener(
+	consumer string,
+	handler topiclistener.EventHandler,
+	readSelectors topicoptions.ReadSelectors,
+	opts ...topicoptions.ReaderOption,
+) (*topiclistener.TopicListener, error) {
+	listener, err := topicreaderinternal.NewListener(
+		c.readerConnector(), consumer, readSelectors, handler, c.readerOptions(opts...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	trace.TopicOnReaderStart(listener.Tracer(), listener.ID(), consumer, err)
+
+	return topiclistener.NewTopicListener(listener, handler)
+}
+
+func (c *Client) readerConnector() topicreaderinternal.TopicSteamReaderConnect {
+	return func(ctx context.Context) (topicreaderinternal.RawTopicReaderStream, error) {
 		return c.rawClient.StreamRead(ctx)
 	}
+}
 
+func (c *Client) readerOptions(opts ...topicoptions.ReaderOption) []topicoptions.ReaderOption {
 	defaultOpts := []topicoptions.ReaderOption{
 		topicoptions.WithCommonConfig(c.cfg.Common),
 		topicreaderinternal.WithCredentials(c.cred),
 		topicreaderinternal.WithTrace(c.cfg.Trace),
+		topicreaderinternal.WithTopicClient(&c.rawClient),
 		topicoptions.WithReaderStartTimeout(topic.DefaultStartTimeout),
 	}
-	opts = append(defaultOpts, opts...)
 
-	internalReader, err := topicreaderinternal.NewReader(connector, consumer, readSelectors, opts...)
+	return append(defaultOpts, opts...)
+}
+
+// StartWriter create new topic writer wrapper
+func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error) {
+	writer, err := topicwriterinternal.NewWriter(c.cred, c.writerOptions(topicPath, opts...))
 	if err != nil {
 		return nil, err
 	}
-	trace.TopicOnReaderStart(internalReader.Tracer(), internalReader.ID(), consumer, err)
 
-	return topicreader.NewReader(internalReader), nil
+	return topicwriter.NewWriter(writer), nil
 }
 
-// StartWriter create new topic writer wrapper
-func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error) {
+// StartTransactionalWriter create new topic writer wrapper which writes messages inside the transaction
+func (c *Client) StartTransactionalWriter(
+	transaction query.TxActor,
+	topicPath string,
+	opts ...topicoptions.WriterOption,
+) (*topicwriter.TxWriter, error) {
+	internalTx, err := tx.AsTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := topicwriterinternal.NewWriterWithTransaction(
+		c.cred, internalTx, c.writerOptions(topicPath, opts...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return topicwriter.NewTxWriter(writer), nil
+}
+
+// StartMultiWriter create new topic writer wrapper to many partitions of the topic
+func (c *Client) StartMultiWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.MultiWriter, error) {
+	writer, err := topicwriterinternal.NewMultiWriter(c.cred, c.writerOptions(topicPath, opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return topicwriter.NewMultiWriter(writer), nil
+}
+
+func (c *Client) writerOptions(topicPath string, opts ...topicoptions.WriterOption) []topicoptions.WriterOption {
 	var connector topicwriterinternal.ConnectFunc = func(ctx context.Context) (
 		topicwriterinternal.RawTopicWriterStream,
 		error,
@@ -241,19 +389,20 @@ func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption
 		return c.rawClient.StreamWrite(ctx)
 	}
 
+	var describer topicwriterinternal.DescribeTopicFunc = func(ctx context.Context, topicPath string) (
+		topictypes.TopicDescription,
+		error,
+	) {
+		return c.Describe(ctx, topicPath)
+	}
+
 	options := []topicoptions.WriterOption{
 		topicwriterinternal.WithConnectFunc(connector),
+		topicwriterinternal.WithDescribeTopicFunc(describer),
 		topicwriterinternal.WithTopic(topicPath),
 		topicwriterinternal.WithCommonConfig(c.cfg.Common),
 		topicwriterinternal.WithTrace(c.cfg.Trace),
 	}
 
-	options = append(options, opts...)
-
-	writer, err := topicwriterinternal.NewWriter(c.cred, options)
-	if err != nil {
-		return nil, err
-	}
-
-	return topicwriter.NewWriter(writer), nil
+	return append(options, opts...)
 }
@@ -45,6 +45,7 @@ type committer struct {
 
 	clock            clockwork.Clock
 	commitLoopSignal empty.Chan
+	commitLoopFlush  empty.Chan
 	backgroundWorker background.Worker
 	tracer           *trace.Topic
 
@@ -68,6 +69,7 @@ func newCommitterStopped(tracer *trace.Topic, lifeContext context.Context, mode
 
 func (c *committer) initChannels() {
 	c.commitLoopSignal = make(empty.Chan, 1)
+	c.commitLoopFlush = make(empty.Chan, 1)
 }
 
 func (c *committer) Start() {
@@ -111,12 +113,30 @@ func (c *committer) pushCommit(commitRange commitRange) (commitWaiter, error) {
 		}
 	})
 
+	// commits of ended partition sent without buffering for allow server start read child partitions
+	if commitRange.partitionSession != nil && commitRange.partitionSession.isEnded() {
+		c.Flush()
+	} else {
+		c.signalCommitLoop()
+	}
+
+	return waiter, resErr
+}
+
+// Flush sends buffered commits to server without wait of buffer triggers
+func (c *committer) Flush() {
 	select {
-	case c.commitLoopSignal <- struct{}{}:
+	case c.commitLoopFlush <- struct{}{}:
 	default:
 	}
+	c.signalCommitLoop()
+}
 
-	return waiter, resErr
+func (c *committer) signalCommitLoop() {
+	select {
+	case c.commitLoopSignal <- struct{}{}:
+	default:
+	}
 }
 
 func (c *committer) pushCommitsLoop(ctx context.Context) {
@@ -174,6 +194,7 @@ func (c *committer) waitSendTrigger(ctx context.Context) {
 		select {
 		case <-ctxDone:
 		case <-finish:
+		case <-c.commitLoopFlush:
 		}
 
 		return
@@ -193,6 +214,8 @@ func (c *committer) waitSendTrigger(ctx context.Context) {
 			return
 		case <-finish:
 			return
+		case <-c.commitLoopFlush:
+			return
 		case <-c.commitLoopSignal:
 			// check count on next loop iteration
 		}
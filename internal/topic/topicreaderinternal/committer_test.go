@@ -348,6 +348,47 @@ func TestCommitterBuffer(t *testing.T) {
 		clock.Advance(time.Second)
 		<-sendCalled
 	})
+	t.Run("CommitOfEndedPartitionIgnoreTimeLag", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		clock := clockwork.NewFakeClock()
+		c := newTestCommitter(ctx, t)
+		c.clock = clock
+		c.BufferTimeLagTrigger = time.Hour
+
+		sendCalled := make(empty.Chan)
+		c.send = func(msg rawtopicreader.ClientMessage) error {
+			close(sendCalled)
+
+			return nil
+		}
+
+		session := &partitionSession{partitionSessionID: 1}
+		session.setEnded()
+		_, err := c.pushCommit(commitRange{partitionSession: session})
+		require.NoError(t, err)
+		xtest.WaitChannelClosed(t, sendCalled)
+	})
+	t.Run("FlushIgnoreTimeLag", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		clock := clockwork.NewFakeClock()
+		c := newTestCommitter(ctx, t)
+		c.clock = clock
+		c.BufferTimeLagTrigger = time.Hour
+
+		sendCalled := make(empty.Chan)
+		c.send = func(msg rawtopicreader.ClientMessage) error {
+			close(sendCalled)
+
+			return nil
+		}
+
+		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 1}})
+		require.NoError(t, err)
+		clock.BlockUntil(1)
+
+		c.Flush()
+		xtest.WaitChannelClosed(t, sendCalled)
+	})
 	t.Run("FireWithEmptyBuffer", func(t *testing.T) {
 		ctx := xtest.Context(t)
 		c := newTestCommitter(ctx, t)
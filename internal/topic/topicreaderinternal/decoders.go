@@ -4,7 +4,9 @@ import (
 	"compress/gzip"
 	"fmt"
 	"io"
+	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
@@ -22,6 +24,7 @@ func newDecoderMap() decoderMap {
 			rawtopiccommon.CodecGzip: func(input io.Reader) (io.Reader, error) {
 				return gzip.NewReader(input)
 			},
+			rawtopiccommon.CodecZstd: newZstdDecoder,
 		},
 	}
 }
@@ -41,3 +44,47 @@ func (m *decoderMap) Decode(codec rawtopiccommon.Codec, input io.Reader) (io.Rea
 }
 
 type PublicCreateDecoderFunc func(input io.Reader) (io.Reader, error)
+
+// zstdDecoderPool reuse zstd decoders, because create of the decoder is expensive
+var zstdDecoderPool sync.Pool
+
+// zstdDecoder return decoder to pool after read to end of data.
+// Decoder of not fully read message is released by garbage collector.
+type zstdDecoder struct {
+	decoder *zstd.Decoder
+	err     error
+}
+
+func newZstdDecoder(input io.Reader) (io.Reader, error) {
+	decoder, ok := zstdDecoderPool.Get().(*zstd.Decoder)
+	if ok {
+		if err := decoder.Reset(input); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	} else {
+		var err error
+		// decoder with concurrency 1 decode data synchronously and has no background goroutines
+		decoder, err = zstd.NewReader(input, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return &zstdDecoder{decoder: decoder}, nil
+}
+
+func (d *zstdDecoder) Read(p []byte) (int, error) {
+	if d.decoder == nil {
+		return 0, d.err
+	}
+
+	n, err := d.decoder.Read(p)
+	if err != nil {
+		_ = d.decoder.Reset(nil)
+		zstdDecoderPool.Put(d.decoder)
+		d.decoder = nil
+		d.err = err
+	}
+
+	return n, err
+}
@@ -0,0 +1,45 @@
+package topicreaderinternal
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+)
+
+func TestDecoderMap_Zstd(t *testing.T) {
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+
+	decoders := newDecoderMap()
+
+	// decoders reused through pool after read to end
+	for i := 0; i < 3; i++ {
+		data := strings.Repeat("test", i+1)
+		encoded := encoder.EncodeAll([]byte(data), nil)
+
+		reader, err := decoders.Decode(rawtopiccommon.CodecZstd, bytes.NewReader(encoded))
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, data, string(content))
+
+		n, err := reader.Read(make([]byte, 1))
+		require.Equal(t, 0, n)
+		require.ErrorIs(t, err, io.EOF)
+	}
+
+	t.Run("BadData", func(t *testing.T) {
+		reader, err := decoders.Decode(rawtopiccommon.CodecZstd, bytes.NewReader([]byte("bad data")))
+		if err == nil {
+			_, err = io.ReadAll(reader)
+		}
+		require.Error(t, err)
+	})
+}
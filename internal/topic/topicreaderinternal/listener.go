@@ -0,0 +1,186 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/background"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var errListenerClosed = xerrors.Wrap(errors.New("ydb: topic listener closed"))
+
+// ListenerEventHandler is a handler of topic listener events.
+// Events of partition sessions called from internal goroutine of reader stream,
+// read messages events called sequentially from read loop of the listener
+type ListenerEventHandler interface {
+	OnStartPartitionSession(ctx context.Context, event *PublicEventStartPartitionSession) error
+	OnStopPartitionSession(ctx context.Context, event *PublicEventStopPartitionSession) error
+	OnReadMessages(ctx context.Context, event *PublicReadMessages) error
+}
+
+// PublicReadMessages is an event with batch of messages from one partition
+type PublicReadMessages struct {
+	PartitionSession PublicPartitionSession
+	Batch            *PublicBatch
+
+	commit func(ctx context.Context) error
+}
+
+// Commit commits messages of the event with commit mode of the listener
+func (e *PublicReadMessages) Commit(ctx context.Context) error {
+	return e.commit(ctx)
+}
+
+// Listener reads messages from topic in background and push them with partition session events to handler
+type Listener struct {
+	reader     Reader
+	handler    ListenerEventHandler
+	background *background.Worker
+
+	started    empty.Chan
+	startOnce  sync.Once
+	stopped    empty.Chan
+	stopOnce   sync.Once
+	stopReason error
+}
+
+func NewListener(
+	connector TopicSteamReaderConnect,
+	consumer string,
+	readSelectors []PublicReadSelector,
+	handler ListenerEventHandler,
+	opts ...PublicReaderOption,
+) (*Listener, error) {
+	res := &Listener{
+		handler:    handler,
+		background: background.NewWorker(context.Background(), "topic listener"),
+		started:    make(empty.Chan),
+		stopped:    make(empty.Chan),
+	}
+
+	opts = append(opts, func(cfg *ReaderConfig) {
+		cfg.partitionEvents = res
+	})
+
+	reader, err := NewReader(connector, consumer, readSelectors, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res.reader = reader
+
+	return res, nil
+}
+
+func (l *Listener) ID() int64 {
+	return l.reader.ID()
+}
+
+func (l *Listener) Tracer() *trace.Topic {
+	return l.reader.Tracer()
+}
+
+// Start starts push of events to handler.
+// Events of partition sessions received before start wait the start
+func (l *Listener) Start() {
+	l.startOnce.Do(func() {
+		close(l.started)
+		l.background.Start("topic listener read loop", l.readLoop)
+	})
+}
+
+func (l *Listener) WaitInit(ctx context.Context) error {
+	return l.reader.WaitInit(ctx)
+}
+
+// WaitStop waits until the listener stopped and returns reason of stop
+func (l *Listener) WaitStop(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.stopped:
+		return l.stopReason
+	}
+}
+
+func (l *Listener) Close(ctx context.Context) error {
+	l.stop(ctx, xerrors.WithStackTrace(errListenerClosed))
+
+	return l.background.Close(ctx, xerrors.WithStackTrace(errListenerClosed))
+}
+
+func (l *Listener) stop(ctx context.Context, reason error) {
+	l.stopOnce.Do(func() {
+		l.stopReason = reason
+		close(l.stopped)
+		_ = l.reader.reader.CloseWithError(ctx, reason)
+	})
+}
+
+func (l *Listener) readLoop(ctx context.Context) {
+	for {
+		batch, err := l.reader.ReadMessageBatch(ctx)
+		if err != nil {
+			l.stop(ctx, err)
+
+			return
+		}
+
+		event := &PublicReadMessages{
+			PartitionSession: newPublicPartitionSession(batch.partitionSession()),
+			Batch:            batch,
+			commit: func(ctx context.Context) error {
+				return l.reader.Commit(ctx, batch)
+			},
+		}
+		if err = l.handler.OnReadMessages(batch.Context(), event); err != nil {
+			l.stop(ctx, xerrors.WithStackTrace(err))
+
+			return
+		}
+	}
+}
+
+// waitStart waits start of the listener before push events to handler
+func (l *Listener) waitStart(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.stopped:
+		return l.stopReason
+	case <-l.started:
+		return nil
+	}
+}
+
+func (l *Listener) onStartPartitionSession(ctx context.Context, event *PublicEventStartPartitionSession) error {
+	if err := l.waitStart(ctx); err != nil {
+		return err
+	}
+
+	return l.onHandlerError(l.handler.OnStartPartitionSession(ctx, event))
+}
+
+func (l *Listener) onStopPartitionSession(ctx context.Context, event *PublicEventStopPartitionSession) error {
+	if err := l.waitStart(ctx); err != nil {
+		return err
+	}
+
+	return l.onHandlerError(l.handler.OnStopPartitionSession(ctx, event))
+}
+
+// onHandlerError stops the listener on error of partition sessions events handler.
+// Stop runs in separated goroutine because the events handled in goroutine of reader stream
+func (l *Listener) onHandlerError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	err = xerrors.WithStackTrace(err)
+	go l.stop(context.Background(), err)
+
+	return err
+}
@@ -0,0 +1,127 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+)
+
+// partitionEventsHandler receives partition session lifecycle events of stream reader
+// instead of automatically confirm of start and stop partition sessions
+type partitionEventsHandler interface {
+	onStartPartitionSession(ctx context.Context, event *PublicEventStartPartitionSession) error
+	onStopPartitionSession(ctx context.Context, event *PublicEventStopPartitionSession) error
+}
+
+// PublicPartitionSession is a session of read messages from one partition of topic
+type PublicPartitionSession struct {
+	PartitionSessionID int64
+	TopicPath          string
+	PartitionID        int64
+}
+
+func newPublicPartitionSession(session *partitionSession) PublicPartitionSession {
+	return PublicPartitionSession{
+		PartitionSessionID: session.partitionSessionID.ToInt64(),
+		TopicPath:          session.Topic,
+		PartitionID:        session.PartitionID,
+	}
+}
+
+// PublicOffsetsRange is a range of offsets [Start, End)
+type PublicOffsetsRange struct {
+	Start int64
+	End   int64
+}
+
+// PublicEventStartPartitionSession is an event of start read from partition.
+// Server doesn't send messages of the partition until the event confirmed
+type PublicEventStartPartitionSession struct {
+	PartitionSession PublicPartitionSession
+	CommittedOffset  int64
+	PartitionOffsets PublicOffsetsRange
+
+	confirm     func(readOffset, commitOffset *int64)
+	confirmOnce sync.Once
+}
+
+func newStartPartitionSessionEvent(
+	session *partitionSession,
+	m *rawtopicreader.StartPartitionSessionRequest,
+	confirm func(readOffset, commitOffset *int64),
+) *PublicEventStartPartitionSession {
+	return &PublicEventStartPartitionSession{
+		PartitionSession: newPublicPartitionSession(session),
+		CommittedOffset:  m.CommittedOffset.ToInt64(),
+		PartitionOffsets: PublicOffsetsRange{
+			Start: m.PartitionOffsets.Start.ToInt64(),
+			End:   m.PartitionOffsets.End.ToInt64(),
+		},
+		confirm: confirm,
+	}
+}
+
+// Confirm starts read from the partition from committed offset
+func (e *PublicEventStartPartitionSession) Confirm() {
+	e.ConfirmWithParams(PublicStartPartitionSessionConfirm{})
+}
+
+// ConfirmWithParams starts read from the partition with custom offsets.
+// Only first confirm of the event is applied, other confirms are ignored
+func (e *PublicEventStartPartitionSession) ConfirmWithParams(p PublicStartPartitionSessionConfirm) {
+	e.confirmOnce.Do(func() {
+		e.confirm(p.readOffset, p.commitOffset)
+	})
+}
+
+// PublicStartPartitionSessionConfirm is parameters of confirm of start partition session
+type PublicStartPartitionSessionConfirm struct {
+	readOffset   *int64
+	commitOffset *int64
+}
+
+// WithReadOffset sets offset of first message which server will send for the partition
+func (c PublicStartPartitionSessionConfirm) WithReadOffset(offset int64) PublicStartPartitionSessionConfirm {
+	c.readOffset = &offset
+
+	return c
+}
+
+// WithCommitOffset sets committed offset of the partition before start read
+func (c PublicStartPartitionSessionConfirm) WithCommitOffset(offset int64) PublicStartPartitionSessionConfirm {
+	c.commitOffset = &offset
+
+	return c
+}
+
+// PublicEventStopPartitionSession is an event of stop read from partition.
+// Graceful stop must be confirmed after client finished work with messages of the partition.
+// Not graceful stop means the partition session already stopped by server and confirm is not needed
+type PublicEventStopPartitionSession struct {
+	PartitionSession PublicPartitionSession
+	Graceful         bool
+	CommittedOffset  int64
+
+	confirm     func()
+	confirmOnce sync.Once
+}
+
+func newStopPartitionSessionEvent(
+	session *partitionSession,
+	m *rawtopicreader.StopPartitionSessionRequest,
+	confirm func(),
+) *PublicEventStopPartitionSession {
+	return &PublicEventStopPartitionSession{
+		PartitionSession: newPublicPartitionSession(session),
+		Graceful:         m.Graceful,
+		CommittedOffset:  m.CommittedOffset.ToInt64(),
+		confirm:          confirm,
+	}
+}
+
+// Confirm stops the partition session.
+// Only first confirm of the event is applied, other confirms are ignored
+func (e *PublicEventStopPartitionSession) Confirm() {
+	e.confirmOnce.Do(e.confirm)
+}
@@ -0,0 +1,214 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testPartitionEventsHandler struct {
+	startEvents chan *PublicEventStartPartitionSession
+	stopEvents  chan *PublicEventStopPartitionSession
+}
+
+func newTestPartitionEventsHandler() *testPartitionEventsHandler {
+	return &testPartitionEventsHandler{
+		startEvents: make(chan *PublicEventStartPartitionSession, 1),
+		stopEvents:  make(chan *PublicEventStopPartitionSession, 1),
+	}
+}
+
+func (h *testPartitionEventsHandler) onStartPartitionSession(
+	_ context.Context,
+	event *PublicEventStartPartitionSession,
+) error {
+	h.startEvents <- event
+
+	return nil
+}
+
+func (h *testPartitionEventsHandler) onStopPartitionSession(
+	_ context.Context,
+	event *PublicEventStopPartitionSession,
+) error {
+	h.stopEvents <- event
+
+	return nil
+}
+
+// readMessagesInBackground reads messages from the reader for pass partition events from buffer
+func readMessagesInBackground(e *streamEnv) {
+	go func() {
+		_, _ = e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+	}()
+}
+
+func TestStreamReaderImpl_PartitionEvents(t *testing.T) {
+	xtest.TestManyTimesWithName(t, "StartConfirmWithParams", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		handler := newTestPartitionEventsHandler()
+		e.reader.cfg.partitionEvents = handler
+		e.Start()
+		readMessagesInBackground(&e)
+
+		e.SendFromServer(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: 16,
+				Path:               "/test",
+				PartitionID:        6,
+			},
+			CommittedOffset: 10,
+			PartitionOffsets: rawtopicreader.OffsetRange{
+				Start: 5,
+				End:   30,
+			},
+		})
+
+		event := <-handler.startEvents
+		require.Equal(t, PublicPartitionSession{
+			PartitionSessionID: 16,
+			TopicPath:          "/test",
+			PartitionID:        6,
+		}, event.PartitionSession)
+		require.Equal(t, int64(10), event.CommittedOffset)
+		require.Equal(t, PublicOffsetsRange{Start: 5, End: 30}, event.PartitionOffsets)
+
+		expectedResponse := &rawtopicreader.StartPartitionSessionResponse{PartitionSessionID: 16}
+		expectedResponse.ReadOffset.FromInt64(12)
+		expectedResponse.CommitOffset.FromInt64(11)
+
+		responseSent := make(empty.Chan)
+		e.stream.EXPECT().Send(expectedResponse).Return(nil).Do(func(_ interface{}) {
+			close(responseSent)
+		})
+
+		event.ConfirmWithParams(PublicStartPartitionSessionConfirm{}.WithReadOffset(12).WithCommitOffset(11))
+		event.Confirm() // second confirm ignored
+		xtest.WaitChannelClosed(t, responseSent)
+	})
+	xtest.TestManyTimesWithName(t, "StopGraceful", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		handler := newTestPartitionEventsHandler()
+		e.reader.cfg.partitionEvents = handler
+		e.Start()
+		readMessagesInBackground(&e)
+
+		e.SendFromServer(&rawtopicreader.StopPartitionSessionRequest{
+			PartitionSessionID: e.partitionSessionID,
+			Graceful:           true,
+			CommittedOffset:    25,
+		})
+
+		event := <-handler.stopEvents
+		require.True(t, event.Graceful)
+		require.Equal(t, int64(25), event.CommittedOffset)
+		require.NoError(t, e.partitionSession.Context().Err())
+
+		responseSent := make(empty.Chan)
+		e.stream.EXPECT().Send(&rawtopicreader.StopPartitionSessionResponse{
+			PartitionSessionID: e.partitionSessionID,
+		}).Return(nil).Do(func(_ interface{}) {
+			close(responseSent)
+		})
+
+		event.Confirm()
+		xtest.WaitChannelClosed(t, responseSent)
+		require.Error(t, e.partitionSession.Context().Err())
+	})
+	xtest.TestManyTimesWithName(t, "StopNotGraceful", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		handler := newTestPartitionEventsHandler()
+		e.reader.cfg.partitionEvents = handler
+		e.Start()
+		readMessagesInBackground(&e)
+
+		e.SendFromServer(&rawtopicreader.StopPartitionSessionRequest{
+			PartitionSessionID: e.partitionSessionID,
+		})
+
+		event := <-handler.stopEvents
+		require.False(t, event.Graceful)
+		require.Error(t, e.partitionSession.Context().Err())
+	})
+}
+
+type testListenerEventHandler struct {
+	startEvents chan *PublicEventStartPartitionSession
+	startErr    error
+}
+
+func (h *testListenerEventHandler) OnStartPartitionSession(
+	_ context.Context,
+	event *PublicEventStartPartitionSession,
+) error {
+	h.startEvents <- event
+
+	return h.startErr
+}
+
+func (h *testListenerEventHandler) OnStopPartitionSession(context.Context, *PublicEventStopPartitionSession) error {
+	return nil
+}
+
+func (h *testListenerEventHandler) OnReadMessages(context.Context, *PublicReadMessages) error {
+	return nil
+}
+
+func TestListener_PartitionEvents(t *testing.T) {
+	newListener := func(t *testing.T, handler ListenerEventHandler) *Listener {
+		baseReader := NewMockbatchedStreamReader(gomock.NewController(t))
+		baseReader.EXPECT().CloseWithError(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		return &Listener{
+			handler: handler,
+			started: make(empty.Chan),
+			stopped: make(empty.Chan),
+			reader:  Reader{reader: baseReader},
+		}
+	}
+
+	t.Run("WaitStart", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		handler := &testListenerEventHandler{startEvents: make(chan *PublicEventStartPartitionSession, 1)}
+		l := newListener(t, handler)
+
+		handled := make(empty.Chan)
+		go func() {
+			defer close(handled)
+			require.NoError(t, l.onStartPartitionSession(ctx, &PublicEventStartPartitionSession{}))
+		}()
+
+		select {
+		case <-handler.startEvents:
+			t.Fatal("event handled before start of listener")
+		case <-handled:
+			t.Fatal("event handled before start of listener")
+		default:
+		}
+
+		close(l.started)
+		xtest.WaitChannelClosed(t, handled)
+		require.Len(t, handler.startEvents, 1)
+	})
+	t.Run("StopOnHandlerError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		testErr := errors.New("test error")
+		handler := &testListenerEventHandler{
+			startEvents: make(chan *PublicEventStartPartitionSession, 1),
+			startErr:    testErr,
+		}
+		l := newListener(t, handler)
+		close(l.started)
+
+		err := l.onStartPartitionSession(ctx, &PublicEventStartPartitionSession{})
+		require.ErrorIs(t, err, testErr)
+		require.ErrorIs(t, l.WaitStop(ctx), testErr)
+	})
+}
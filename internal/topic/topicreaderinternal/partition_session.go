@@ -30,6 +30,7 @@ type partitionSession struct {
 
 	lastReceivedOffsetEndVal atomic.Int64
 	committedOffsetVal       atomic.Int64
+	endedVal                 atomic.Bool
 }
 
 func newPartitionSession(
@@ -66,6 +67,16 @@ func (s *partitionSession) Close() {
 	s.ctxCancel()
 }
 
+// isEnded return true if server sent all messages of the partition and partition will not receive new messages,
+// for example after split or merge of partitions
+func (s *partitionSession) isEnded() bool {
+	return s.endedVal.Load()
+}
+
+func (s *partitionSession) setEnded() {
+	s.endedVal.Store(true)
+}
+
 func (s *partitionSession) committedOffset() rawtopicreader.Offset {
 	v := s.committedOffsetVal.Load()
 
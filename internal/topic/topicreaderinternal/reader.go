@@ -12,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -51,6 +52,11 @@ type Reader struct {
 	defaultBatchConfig ReadMessageBatchOptions
 	tracer             *trace.Topic
 	readerID           int64
+
+	// topicClient and operationParams used for commit offsets inside transactions
+	topicClient     TopicClient
+	operationParams rawydb.OperationParams
+	consumer        string
 }
 
 type ReadMessageBatchOptions struct {
@@ -114,7 +120,10 @@ func NewReader(
 		defaultBatchConfig: cfg.DefaultBatchConfig,
 		tracer:             cfg.Trace,
 		readerID:           readerID,
+		topicClient:        cfg.TopicClient,
+		consumer:           cfg.Consumer,
 	}
+	topic.OperationParamsFromConfig(&res.operationParams, &cfg.Common)
 
 	return res, nil
 }
@@ -231,6 +240,7 @@ type ReaderConfig struct {
 
 	RetrySettings      topic.RetrySettings
 	DefaultBatchConfig ReadMessageBatchOptions
+	TopicClient        TopicClient
 	topicStreamReaderConfig
 }
 
@@ -259,6 +269,13 @@ func WithCredentials(cred credentials.Credentials) PublicReaderOption {
 	}
 }
 
+// WithTopicClient sets raw topic client for commit offsets inside transactions
+func WithTopicClient(client TopicClient) PublicReaderOption {
+	return func(cfg *ReaderConfig) {
+		cfg.TopicClient = client
+	}
+}
+
 func WithTrace(tracer *trace.Topic) PublicReaderOption {
 	return func(cfg *ReaderConfig) {
 		cfg.Trace = cfg.Trace.Compose(tracer)
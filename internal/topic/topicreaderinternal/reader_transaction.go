@@ -0,0 +1,104 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	errNoTopicClient             = xerrors.Wrap(errors.New("ydb: reader has no topic client for commit offsets inside transaction")) //nolint:lll
+	errCommitOffsetsInTxRollback = xerrors.Wrap(errors.New("ydb: transaction with read messages rollbacked"))
+)
+
+// TopicClient is a part of raw topic client which used by reader for operations inside transactions
+type TopicClient interface {
+	UpdateOffsetsInTransaction(
+		ctx context.Context,
+		req *rawtopic.UpdateOffsetsInTransactionRequest,
+	) (rawtopic.UpdateOffsetsInTransactionResult, error)
+}
+
+// transactionReconnector is a reader which can restart read stream after rollback of transaction
+type transactionReconnector interface {
+	TriggerReconnect(reason error)
+}
+
+// PopMessagesBatchTx reads batch of messages and commits its offsets inside the transaction.
+// Offsets become committed on commit of the transaction. If the transaction rollbacked -
+// the reader reconnects and messages will be read again
+func (r *Reader) PopMessagesBatchTx(
+	ctx context.Context,
+	transaction tx.Transaction,
+	opts ...PublicReadBatchOption,
+) (*PublicBatch, error) {
+	if r.topicClient == nil {
+		return nil, xerrors.WithStackTrace(errNoTopicClient)
+	}
+
+	if err := transaction.UnLazy(ctx); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	batch, err := r.ReadMessageBatch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.updateOffsetsInTransaction(ctx, transaction, batch.commitRange); err != nil {
+		// read messages can't be committed, read them again with new stream
+		r.triggerReconnect(err)
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	transaction.OnCompleted(func(err error) {
+		if err != nil {
+			r.triggerReconnect(xerrors.WithStackTrace(errCommitOffsetsInTxRollback))
+		}
+	})
+
+	return batch, nil
+}
+
+func (r *Reader) updateOffsetsInTransaction(ctx context.Context, transaction tx.Transaction, cr commitRange) error {
+	req := &rawtopic.UpdateOffsetsInTransactionRequest{
+		OperationParams: r.operationParams,
+		Tx: rawtopiccommon.TransactionIdentity{
+			ID:      transaction.ID(),
+			Session: transaction.SessionID(),
+		},
+		Topics: []rawtopic.UpdateOffsetsInTransactionRequestTopicOffsets{
+			{
+				Path: cr.partitionSession.Topic,
+				Partitions: []rawtopic.UpdateOffsetsInTransactionRequestPartitionOffsets{
+					{
+						PartitionID: cr.partitionSession.PartitionID,
+						PartitionOffsets: []rawtopicreader.OffsetRange{
+							{
+								Start: cr.commitOffsetStart,
+								End:   cr.commitOffsetEnd,
+							},
+						},
+					},
+				},
+			},
+		},
+		Consumer: r.consumer,
+	}
+
+	_, err := r.topicClient.UpdateOffsetsInTransaction(ctx, req)
+
+	return err
+}
+
+func (r *Reader) triggerReconnect(reason error) {
+	if reconnector, ok := r.reader.(transactionReconnector); ok {
+		reconnector.TriggerReconnect(reason)
+	}
+}
@@ -0,0 +1,165 @@
+package topicreaderinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testTransaction struct {
+	id          string
+	unLazyCalls int
+	onCompleted []tx.OnCompletedFunc
+}
+
+func (t *testTransaction) ID() string {
+	return t.id
+}
+
+func (t *testTransaction) SessionID() string {
+	return "session"
+}
+
+func (t *testTransaction) UnLazy(context.Context) error {
+	t.unLazyCalls++
+	if t.id == "" {
+		t.id = "tx"
+	}
+
+	return nil
+}
+
+func (t *testTransaction) OnBeforeCommit(tx.OnBeforeCommitFunc) {}
+
+func (t *testTransaction) OnCompleted(f tx.OnCompletedFunc) {
+	t.onCompleted = append(t.onCompleted, f)
+}
+
+func (t *testTransaction) complete(err error) {
+	for _, f := range t.onCompleted {
+		f(err)
+	}
+}
+
+type testTopicClient struct {
+	requests []*rawtopic.UpdateOffsetsInTransactionRequest
+	err      error
+}
+
+func (c *testTopicClient) UpdateOffsetsInTransaction(
+	_ context.Context,
+	req *rawtopic.UpdateOffsetsInTransactionRequest,
+) (rawtopic.UpdateOffsetsInTransactionResult, error) {
+	c.requests = append(c.requests, req)
+
+	return rawtopic.UpdateOffsetsInTransactionResult{}, c.err
+}
+
+type testReconnector struct {
+	*MockbatchedStreamReader
+
+	reasons []error
+}
+
+func (r *testReconnector) TriggerReconnect(reason error) {
+	r.reasons = append(r.reasons, reason)
+}
+
+func TestReader_PopMessagesBatchTx(t *testing.T) {
+	newBatch := func() *PublicBatch {
+		session := newPartitionSession(context.Background(), "topic", 2, 0, "", 3, 0)
+
+		return &PublicBatch{
+			Messages: []*PublicMessage{{}},
+			commitRange: commitRange{
+				commitOffsetStart: 10,
+				commitOffsetEnd:   15,
+				partitionSession:  session,
+			},
+		}
+	}
+
+	t.Run("Committed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		mc := gomock.NewController(t)
+		baseReader := &testReconnector{MockbatchedStreamReader: NewMockbatchedStreamReader(mc)}
+		baseReader.EXPECT().ReadMessageBatch(gomock.Any(), gomock.Any()).Return(newBatch(), nil)
+		client := &testTopicClient{}
+		reader := &Reader{
+			reader:      baseReader,
+			topicClient: client,
+			consumer:    "consumer",
+		}
+		transaction := &testTransaction{}
+
+		batch, err := reader.PopMessagesBatchTx(ctx, transaction)
+		require.NoError(t, err)
+		require.Len(t, batch.Messages, 1)
+		require.Equal(t, 1, transaction.unLazyCalls)
+		require.Len(t, client.requests, 1)
+		require.Equal(t, &rawtopic.UpdateOffsetsInTransactionRequest{
+			Tx: rawtopiccommon.TransactionIdentity{ID: "tx", Session: "session"},
+			Topics: []rawtopic.UpdateOffsetsInTransactionRequestTopicOffsets{{
+				Path: "topic",
+				Partitions: []rawtopic.UpdateOffsetsInTransactionRequestPartitionOffsets{{
+					PartitionID:      2,
+					PartitionOffsets: []rawtopicreader.OffsetRange{{Start: 10, End: 15}},
+				}},
+			}},
+			Consumer: "consumer",
+		}, client.requests[0])
+
+		transaction.complete(nil)
+		require.Empty(t, baseReader.reasons)
+	})
+	t.Run("Rollbacked", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		mc := gomock.NewController(t)
+		baseReader := &testReconnector{MockbatchedStreamReader: NewMockbatchedStreamReader(mc)}
+		baseReader.EXPECT().ReadMessageBatch(gomock.Any(), gomock.Any()).Return(newBatch(), nil)
+		reader := &Reader{
+			reader:      baseReader,
+			topicClient: &testTopicClient{},
+		}
+		transaction := &testTransaction{}
+
+		_, err := reader.PopMessagesBatchTx(ctx, transaction)
+		require.NoError(t, err)
+
+		transaction.complete(errors.New("rollback"))
+		require.Len(t, baseReader.reasons, 1)
+		require.ErrorIs(t, baseReader.reasons[0], errCommitOffsetsInTxRollback)
+	})
+	t.Run("UpdateOffsetsFailed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		mc := gomock.NewController(t)
+		baseReader := &testReconnector{MockbatchedStreamReader: NewMockbatchedStreamReader(mc)}
+		baseReader.EXPECT().ReadMessageBatch(gomock.Any(), gomock.Any()).Return(newBatch(), nil)
+		testErr := errors.New("test error")
+		reader := &Reader{
+			reader:      baseReader,
+			topicClient: &testTopicClient{err: testErr},
+		}
+		transaction := &testTransaction{}
+
+		batch, err := reader.PopMessagesBatchTx(ctx, transaction)
+		require.ErrorIs(t, err, testErr)
+		require.Nil(t, batch)
+		require.Len(t, baseReader.reasons, 1)
+		require.Empty(t, transaction.onCompleted)
+	})
+	t.Run("NoTopicClient", func(t *testing.T) {
+		reader := &Reader{}
+		_, err := reader.PopMessagesBatchTx(xtest.Context(t), &testTransaction{})
+		require.ErrorIs(t, err, errNoTopicClient)
+	})
+}
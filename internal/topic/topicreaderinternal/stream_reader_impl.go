@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"reflect"
 	"runtime/pprof"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -66,11 +67,15 @@ type topicStreamReaderConfig struct {
 	CredUpdateInterval              time.Duration
 	Consumer                        string
 	ReadWithoutConsumer             bool
+	AutoPartitioningSupport         bool
 	ReadSelectors                   []*PublicReadSelector
 	Trace                           *trace.Topic
 	GetPartitionStartOffsetCallback PublicGetPartitionStartOffsetFunc
 	CommitMode                      PublicCommitMode
 	Decoders                        decoderMap
+
+	// partitionEvents replaces automatically confirm of start and stop partition sessions if set
+	partitionEvents partitionEventsHandler
 }
 
 func newTopicStreamReaderConfig() topicStreamReaderConfig {
@@ -88,7 +93,8 @@ func newTopicStreamReaderConfig() topicStreamReaderConfig {
 
 func (cfg *topicStreamReaderConfig) initMessage() *rawtopicreader.InitRequest {
 	res := &rawtopicreader.InitRequest{
-		Consumer: cfg.Consumer,
+		Consumer:                cfg.Consumer,
+		AutoPartitioningSupport: cfg.AutoPartitioningSupport,
 	}
 
 	res.TopicsReadSettings = make([]rawtopicreader.TopicReadSettings, len(cfg.ReadSelectors))
@@ -284,6 +290,8 @@ func (r *topicStreamReaderImpl) consumeRawMessageFromBuffer(ctx context.Context)
 			}
 		case *rawtopicreader.PartitionSessionStatusResponse:
 			r.onPartitionSessionStatusResponseFromBuffer(ctx, m)
+		case *rawtopicreader.EndPartitionSession:
+			r.onEndPartitionSessionFromBuffer(m)
 		default:
 			_ = r.CloseWithError(ctx, xerrors.WithStackTrace(
 				fmt.Errorf("ydb: unexpected server message from buffer: %v", reflect.TypeOf(msg))),
@@ -292,6 +300,18 @@ func (r *topicStreamReaderImpl) consumeRawMessageFromBuffer(ctx context.Context)
 	}
 }
 
+func (r *topicStreamReaderImpl) onEndPartitionSessionFromBuffer(msg *rawtopicreader.EndPartitionSession) {
+	session, err := r.sessionController.Get(msg.PartitionSessionID)
+	if err != nil {
+		// partition session can be stopped before end message processed
+		return
+	}
+
+	// all messages of the partition already consumed from buffer, only commits of them remained
+	session.setEnded()
+	r.committer.Flush()
+}
+
 func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 	msg *rawtopicreader.StopPartitionSessionRequest,
 ) (err error) {
@@ -313,6 +333,10 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 			msg.Graceful,
 		)
 	)
+	if r.cfg.partitionEvents != nil {
+		return r.onStopPartitionSessionEvent(session, msg, onDone)
+	}
+
 	defer func() {
 		onDone(err)
 	}()
@@ -341,6 +365,47 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 	return nil
 }
 
+// onStopPartitionSessionEvent passes stop partition session request to partition events handler.
+// Graceful stop completes after confirm of the event by handler
+func (r *topicStreamReaderImpl) onStopPartitionSessionEvent(
+	session *partitionSession,
+	msg *rawtopicreader.StopPartitionSessionRequest,
+	onDone func(error),
+) error {
+	if !msg.Graceful {
+		// double message with graceful=false is ok, session may be removed while process graceful stop
+		_, _ = r.sessionController.Remove(session.partitionSessionID)
+		err := r.cfg.partitionEvents.onStopPartitionSession(
+			session.Context(),
+			newStopPartitionSessionEvent(session, msg, func() {}),
+		)
+		onDone(err)
+
+		return err
+	}
+
+	// trace must be completed once: with confirm or with error of handler
+	var onDoneOnce sync.Once
+	event := newStopPartitionSessionEvent(session, msg, func() {
+		session.Close()
+		err := r.send(&rawtopicreader.StopPartitionSessionResponse{
+			PartitionSessionID: session.partitionSessionID,
+		})
+		if err == nil {
+			_, err = r.sessionController.Remove(session.partitionSessionID)
+		}
+		onDoneOnce.Do(func() { onDone(err) })
+	})
+
+	if err := r.cfg.partitionEvents.onStopPartitionSession(session.Context(), event); err != nil {
+		onDoneOnce.Do(func() { onDone(err) })
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *topicStreamReaderImpl) onPartitionSessionStatusResponseFromBuffer(
 	ctx context.Context,
 	m *rawtopicreader.PartitionSessionStatusResponse,
@@ -534,6 +599,12 @@ func (r *topicStreamReaderImpl) readMessagesLoop(ctx context.Context) {
 			if err = r.onStopPartitionSessionRequest(m); err != nil {
 				_ = r.CloseWithError(ctx, err)
 
+				return
+			}
+		case *rawtopicreader.EndPartitionSession:
+			if err = r.onEndPartitionSession(m); err != nil {
+				_ = r.CloseWithError(ctx, err)
+
 				return
 			}
 		case *rawtopicreader.CommitOffsetResponse:
@@ -794,6 +865,10 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		PartitionSessionID: session.partitionSessionID,
 	}
 
+	if r.cfg.partitionEvents != nil {
+		return r.onStartPartitionSessionEvent(session, m, respMessage, onDone)
+	}
+
 	var forceOffset *int64
 	var commitOffset *int64
 
@@ -825,6 +900,46 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 	return r.send(respMessage)
 }
 
+// onStartPartitionSessionEvent passes start partition session request to partition events handler.
+// Response sends to server after confirm of the event by handler
+func (r *topicStreamReaderImpl) onStartPartitionSessionEvent(
+	session *partitionSession,
+	m *rawtopicreader.StartPartitionSessionRequest,
+	respMessage *rawtopicreader.StartPartitionSessionResponse,
+	onDone func(readOffset *int64, commitOffset *int64, err error),
+) error {
+	// trace must be completed once: with confirm or with error of handler
+	var onDoneOnce sync.Once
+	event := newStartPartitionSessionEvent(session, m, func(readOffset, commitOffset *int64) {
+		respMessage.ReadOffset.FromInt64Pointer(readOffset)
+		if r.cfg.CommitMode.commitsEnabled() {
+			respMessage.CommitOffset.FromInt64Pointer(commitOffset)
+		} else {
+			commitOffset = nil
+		}
+		err := r.send(respMessage)
+		onDoneOnce.Do(func() { onDone(readOffset, commitOffset, err) })
+	})
+
+	if err := r.cfg.partitionEvents.onStartPartitionSession(session.Context(), event); err != nil {
+		onDoneOnce.Do(func() { onDone(nil, nil, err) })
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *topicStreamReaderImpl) onEndPartitionSession(m *rawtopicreader.EndPartitionSession) error {
+	session, err := r.sessionController.Get(m.PartitionSessionID)
+	if err != nil {
+		return err
+	}
+
+	// pass through the buffer for handle end of partition after all messages of the partition
+	return r.batcher.PushRawMessage(session, m)
+}
+
 func (r *topicStreamReaderImpl) onStopPartitionSessionRequest(m *rawtopicreader.StopPartitionSessionRequest) error {
 	session, err := r.sessionController.Get(m.PartitionSessionID)
 	if err != nil {
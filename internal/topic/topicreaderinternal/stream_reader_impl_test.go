@@ -453,6 +453,42 @@ func TestStreamReaderImpl_OnPartitionCloseHandle(t *testing.T) {
 	})
 }
 
+func TestStreamReaderImpl_OnEndPartitionSession(t *testing.T) {
+	xtest.TestManyTimesWithName(t, "MarkSessionEnded", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+		readMessagesInBackground(&e)
+
+		e.SendFromServer(&rawtopicreader.EndPartitionSession{
+			PartitionSessionID: e.partitionSessionID,
+			ChildPartitionIDs:  []int64{6, 7},
+		})
+
+		xtest.SpinWaitCondition(t, nil, e.partitionSession.isEnded)
+		require.NoError(t, e.partitionSession.Context().Err())
+	})
+	xtest.TestManyTimesWithName(t, "UnknownSession", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		e.Start()
+
+		e.SendFromServer(&rawtopicreader.EndPartitionSession{
+			PartitionSessionID: e.partitionSessionID + 1,
+		})
+
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return e.reader.ctx.Err() != nil
+		})
+	})
+}
+
+func TestTopicStreamReaderConfig_InitMessageAutoPartitioning(t *testing.T) {
+	cfg := newTopicStreamReaderConfig()
+	require.False(t, cfg.initMessage().AutoPartitioningSupport)
+
+	cfg.AutoPartitioningSupport = true
+	require.True(t, cfg.initMessage().AutoPartitioningSupport)
+}
+
 func TestTopicStreamReaderImpl_ReadMessages(t *testing.T) {
 	t.Run("BufferSize", func(t *testing.T) {
 		waitChangeRestBufferSizeBytes := func(r *topicStreamReaderImpl, old int64) {
@@ -376,6 +376,23 @@ func (r *readerReconnector) fireReconnectOnRetryableError(stream batchedStreamRe
 	}
 }
 
+// TriggerReconnect closes current stream and starts new one.
+// Messages which were read but not committed will be read again from the new stream
+func (r *readerReconnector) TriggerReconnect(reason error) {
+	var stream batchedStreamReader
+	r.m.WithRLock(func() {
+		stream = r.streamVal
+	})
+
+	select {
+	case r.reconnectFromBadStream <- newReconnectRequest(stream, reason):
+		trace.TopicOnReaderReconnectRequest(r.tracer, reason, true)
+	default:
+		// previous reconnect signal in process, no need sent signal more
+		trace.TopicOnReaderReconnectRequest(r.tracer, reason, false)
+	}
+}
+
 func (r *readerReconnector) stream(ctx context.Context) (batchedStreamReader, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
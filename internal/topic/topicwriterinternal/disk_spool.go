@@ -0,0 +1,521 @@
+package topicwriterinternal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	diskSpoolSegmentExt         = ".wal"
+	diskSpoolRecordHeaderSize   = 8
+	diskSpoolDefaultSegmentSize = 16 * 1024 * 1024
+	diskSpoolReplayBatchSize    = 100
+)
+
+var (
+	errDiskSpoolCorruptedRecord = xerrors.Wrap(errors.New("ydb: corrupted record of topic writer disk spool"))
+	errDiskSpoolClosed          = xerrors.Wrap(errors.New("ydb: topic writer disk spool closed"))
+
+	diskSpoolCRCTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// diskSpool is write-ahead log of topic writer messages.
+// Messages append to segment files before send to server and segments remove after all messages of the segment
+// acked by server. Every record protected by crc, a broken tail of the newest segment (for example after power loss)
+// is ignored and truncated on replay, corrupted records of other segments fail replay.
+//
+// Segment file format: sequence of records
+// record: | payload len uint32 | payload crc32c uint32 | payload |
+// payload: | seqno int64 | created at unix nano int64 | codec int32 | uncompressed size int64 |
+// | metadata count uint32 | (key len uint32 | key | value len uint32 | value) * metadata count |
+// | data len uint32 | data |
+// all numbers are little endian.
+type diskSpool struct {
+	dir         string
+	segmentSize int64
+
+	m          sync.Mutex
+	closed     bool
+	segments   []diskSpoolSegment
+	activeFile diskSpoolFile
+	activeSize int64
+	// activeBroken is true if failed write left a partial record in active segment and truncate of it failed
+	activeBroken bool
+
+	// createFile opens new segment file, it replaced in tests
+	createFile func(path string) (diskSpoolFile, error)
+}
+
+type diskSpoolFile interface {
+	Write(p []byte) (n int, err error)
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+type diskSpoolSegment struct {
+	path      string
+	lastSeqNo int64
+}
+
+type diskSpoolRecord struct {
+	SeqNo            int64
+	CreatedAt        time.Time
+	Metadata         map[string][]byte
+	Codec            rawtopiccommon.Codec
+	UncompressedSize int
+	Data             []byte
+}
+
+// openDiskSpool open spool of the producer in the dir. Spool of every producer stored in own subdirectory.
+func openDiskSpool(dir, producerID string, segmentSize int64) (*diskSpool, error) {
+	if segmentSize <= 0 {
+		segmentSize = diskSpoolDefaultSegmentSize
+	}
+
+	s := &diskSpool{
+		dir:         filepath.Join(dir, url.PathEscape(producerID)),
+		segmentSize: segmentSize,
+		createFile: func(path string) (diskSpoolFile, error) {
+			return os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gomnd
+		},
+	}
+
+	if err := os.MkdirAll(s.dir, 0o700); err != nil { //nolint:gomnd
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: failed create dir of topic writer disk spool: %w", err))
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: failed read dir of topic writer disk spool: %w", err))
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), diskSpoolSegmentExt) {
+			continue
+		}
+		// last seqno of the segment is unknown before replay, the segment can't be removed by ack
+		s.segments = append(s.segments, diskSpoolSegment{
+			path:      filepath.Join(s.dir, entry.Name()),
+			lastSeqNo: math.MaxInt64,
+		})
+	}
+
+	// names of segments has first seqno with leading zeroes, lexical order is same as seqno order
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].path < s.segments[j].path
+	})
+
+	return s, nil
+}
+
+// Replay call f for every stored record with seqno more than afterSeqNo in order of write.
+// Segments without records for replay removed.
+// Replay must be called before first Append.
+func (s *diskSpool) Replay(afterSeqNo int64, f func(rec diskSpoolRecord) error) error {
+	s.m.Lock()
+	segments := make([]diskSpoolSegment, len(s.segments))
+	copy(segments, s.segments)
+	s.m.Unlock()
+
+	for i := range segments {
+		content, err := os.ReadFile(segments[i].path)
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("ydb: failed read topic writer disk spool segment: %w", err))
+		}
+
+		records, validSize, err := decodeDiskSpoolRecords(content)
+		if err != nil {
+			// only the newest segment can be broken by stop of the application while append,
+			// older segments were closed after successful appends
+			if i != len(segments)-1 {
+				return xerrors.WithStackTrace(fmt.Errorf("ydb: failed replay topic writer disk spool segment %q: %w",
+					segments[i].path, err,
+				))
+			}
+
+			// broken tail of the segment skipped, because it is not acked to user as written.
+			// The tail is truncated for the segment stays valid when next appends create newer segments
+			if err = os.Truncate(segments[i].path, int64(validSize)); err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf(
+					"ydb: failed truncate broken tail of topic writer disk spool segment: %w", err,
+				))
+			}
+		}
+		segments[i].lastSeqNo = -1
+		for _, rec := range records {
+			if rec.SeqNo > segments[i].lastSeqNo {
+				segments[i].lastSeqNo = rec.SeqNo
+			}
+		}
+
+		s.setSegmentLastSeqNo(segments[i].path, segments[i].lastSeqNo)
+
+		for _, rec := range records {
+			if rec.SeqNo <= afterSeqNo {
+				continue
+			}
+			if err = f(rec); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.Ack(afterSeqNo)
+}
+
+// setSegmentLastSeqNo update segment by path, because segments can be removed by acks of replayed messages
+func (s *diskSpool) setSegmentLastSeqNo(path string, lastSeqNo int64) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for i := range s.segments {
+		if s.segments[i].path == path {
+			s.segments[i].lastSeqNo = lastSeqNo
+
+			return
+		}
+	}
+}
+
+// Append write messages to active segment and sync it to disk
+func (s *diskSpool) Append(messages []messageWithDataContent) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	var buf []byte
+	for i := range messages {
+		rec, err := newDiskSpoolRecord(&messages[i])
+		if err != nil {
+			return err
+		}
+		buf = rec.appendTo(buf)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.closed {
+		return xerrors.WithStackTrace(errDiskSpoolClosed)
+	}
+
+	if s.activeBroken {
+		// new segment must not be created while partial record stays in active segment,
+		// because replay tolerates a broken tail of the newest segment only
+		if err := s.truncateActiveSegmentNeedLock(); err != nil {
+			return err
+		}
+	}
+
+	if s.activeFile != nil && s.activeSize >= s.segmentSize {
+		if err := s.closeActiveSegmentNeedLock(); err != nil {
+			return err
+		}
+	}
+
+	if s.activeFile == nil {
+		if err := s.createSegmentNeedLock(messages[0].SeqNo); err != nil {
+			return err
+		}
+	}
+
+	_, err := s.activeFile.Write(buf)
+	if err == nil {
+		err = s.activeFile.Sync()
+	}
+	if err != nil {
+		// failed write can leave a partial record, it is cut off for the segment stays valid for next appends
+		s.activeBroken = true
+		_ = s.truncateActiveSegmentNeedLock()
+
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed write to topic writer disk spool: %w", err))
+	}
+	s.activeSize += int64(len(buf))
+	s.segments[len(s.segments)-1].lastSeqNo = messages[len(messages)-1].SeqNo
+
+	return nil
+}
+
+// Ack remove segments with all messages acked by server
+func (s *diskSpool) Ack(seqNo int64) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for len(s.segments) > 0 && s.segments[0].lastSeqNo <= seqNo {
+		if len(s.segments) == 1 && s.activeFile != nil {
+			if err := s.closeActiveSegmentNeedLock(); err != nil {
+				return err
+			}
+		}
+
+		if err := os.Remove(s.segments[0].path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return xerrors.WithStackTrace(fmt.Errorf("ydb: failed remove topic writer disk spool segment: %w", err))
+		}
+		s.segments = s.segments[1:]
+	}
+
+	return nil
+}
+
+func (s *diskSpool) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if s.activeFile == nil {
+		return nil
+	}
+
+	if s.activeBroken {
+		// error ignored: a broken tail of the newest segment skipped on replay
+		_ = s.truncateActiveSegmentNeedLock()
+	}
+
+	return s.closeActiveSegmentNeedLock()
+}
+
+func (s *diskSpool) createSegmentNeedLock(firstSeqNo int64) error {
+	path := filepath.Join(s.dir, fmt.Sprintf("%020d%s", firstSeqNo, diskSpoolSegmentExt))
+	file, err := s.createFile(path)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed create topic writer disk spool segment: %w", err))
+	}
+
+	// sync of dir needs for guarantee the file exists after power loss,
+	// some platforms doesn't support sync of dir - error ignored
+	if dir, err := os.Open(s.dir); err == nil {
+		_ = dir.Sync()
+		_ = dir.Close()
+	}
+
+	s.activeFile = file
+	s.activeSize = 0
+	s.segments = append(s.segments, diskSpoolSegment{path: path, lastSeqNo: -1})
+
+	return nil
+}
+
+// truncateActiveSegmentNeedLock remove records of failed write from active segment
+func (s *diskSpool) truncateActiveSegmentNeedLock() error {
+	err := s.activeFile.Truncate(s.activeSize)
+	if err == nil {
+		err = s.activeFile.Sync()
+	}
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed truncate topic writer disk spool segment: %w", err))
+	}
+	s.activeBroken = false
+
+	return nil
+}
+
+func (s *diskSpool) closeActiveSegmentNeedLock() error {
+	err := s.activeFile.Close()
+	s.activeFile = nil
+	s.activeSize = 0
+	s.activeBroken = false
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed close topic writer disk spool segment: %w", err))
+	}
+
+	return nil
+}
+
+func newDiskSpoolRecord(mess *messageWithDataContent) (diskSpoolRecord, error) {
+	rec := diskSpoolRecord{
+		SeqNo:            mess.SeqNo,
+		CreatedAt:        mess.CreatedAt,
+		Metadata:         mess.Metadata,
+		UncompressedSize: mess.BufUncompressedSize,
+	}
+
+	switch {
+	case mess.hasRawContent:
+		rec.Codec = rawtopiccommon.CodecRaw
+		rec.Data = mess.rawBuf.Bytes()
+	case mess.hasEncodedContent:
+		rec.Codec = mess.bufCodec
+		rec.Data = mess.bufEncoded.Bytes()
+	default:
+		return rec, xerrors.WithStackTrace(errNoRawContent)
+	}
+
+	return rec, nil
+}
+
+func (rec *diskSpoolRecord) message(encoders *EncoderMap) messageWithDataContent {
+	mess := newMessageDataWithContent(PublicMessage{
+		SeqNo:     rec.SeqNo,
+		CreatedAt: rec.CreatedAt,
+		Metadata:  rec.Metadata,
+	}, encoders)
+	mess.dataWasRead = true
+	mess.metadataCached = true
+	mess.BufUncompressedSize = rec.UncompressedSize
+
+	if rec.Codec == rawtopiccommon.CodecRaw {
+		mess.hasRawContent = true
+		mess.rawBuf.Write(rec.Data)
+	} else {
+		mess.hasEncodedContent = true
+		mess.bufCodec = rec.Codec
+		mess.bufEncoded.Write(rec.Data)
+	}
+
+	return mess
+}
+
+func (rec *diskSpoolRecord) appendTo(buf []byte) []byte {
+	var createdAt int64
+	if !rec.CreatedAt.IsZero() {
+		createdAt = rec.CreatedAt.UnixNano()
+	}
+
+	headerPos := len(buf)
+	buf = append(buf, make([]byte, diskSpoolRecordHeaderSize)...)
+	payloadPos := len(buf)
+
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(rec.SeqNo))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(createdAt))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(rec.Codec))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(rec.UncompressedSize))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(rec.Metadata)))
+	for key, val := range rec.Metadata {
+		buf = appendDiskSpoolBytes(buf, []byte(key))
+		buf = appendDiskSpoolBytes(buf, val)
+	}
+	buf = appendDiskSpoolBytes(buf, rec.Data)
+
+	payload := buf[payloadPos:]
+	binary.LittleEndian.PutUint32(buf[headerPos:], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[headerPos+4:], crc32.Checksum(payload, diskSpoolCRCTable))
+
+	return buf
+}
+
+func appendDiskSpoolBytes(buf, data []byte) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(data)))
+
+	return append(buf, data...)
+}
+
+// decodeDiskSpoolRecords decode records from segment content.
+// It returns valid records before first broken record, size of content with the valid records
+// and error about the broken record.
+func decodeDiskSpoolRecords(content []byte) (records []diskSpoolRecord, validSize int, _ error) {
+	for validSize < len(content) {
+		rest := content[validSize:]
+		if len(rest) < diskSpoolRecordHeaderSize {
+			return records, validSize, xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+		}
+		payloadLen := binary.LittleEndian.Uint32(rest)
+		crc := binary.LittleEndian.Uint32(rest[4:])
+		rest = rest[diskSpoolRecordHeaderSize:]
+
+		if uint64(len(rest)) < uint64(payloadLen) {
+			return records, validSize, xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+		}
+		payload := rest[:payloadLen]
+
+		if crc32.Checksum(payload, diskSpoolCRCTable) != crc {
+			return records, validSize, xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+		}
+
+		rec, err := decodeDiskSpoolPayload(payload)
+		if err != nil {
+			return records, validSize, err
+		}
+		records = append(records, rec)
+		validSize += diskSpoolRecordHeaderSize + int(payloadLen)
+	}
+
+	return records, validSize, nil
+}
+
+func decodeDiskSpoolPayload(payload []byte) (rec diskSpoolRecord, _ error) {
+	d := diskSpoolDecoder{buf: payload}
+
+	rec.SeqNo = int64(d.uint64())
+	if createdAt := int64(d.uint64()); createdAt != 0 {
+		rec.CreatedAt = time.Unix(0, createdAt)
+	}
+	rec.Codec = rawtopiccommon.Codec(int32(d.uint32()))
+	rec.UncompressedSize = int(d.uint64())
+
+	metadataCount := d.uint32()
+	for i := uint32(0); i < metadataCount && d.err == nil; i++ {
+		key := d.bytes()
+		val := d.bytes()
+		if rec.Metadata == nil {
+			rec.Metadata = make(map[string][]byte)
+		}
+		rec.Metadata[string(key)] = val
+	}
+	rec.Data = d.bytes()
+
+	if d.err == nil && len(d.buf) != 0 {
+		d.err = xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+	}
+
+	return rec, d.err
+}
+
+type diskSpoolDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *diskSpoolDecoder) uint32() uint32 {
+	if d.err != nil || len(d.buf) < 4 { //nolint:gomnd
+		d.err = xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+
+		return 0
+	}
+	res := binary.LittleEndian.Uint32(d.buf)
+	d.buf = d.buf[4:]
+
+	return res
+}
+
+func (d *diskSpoolDecoder) uint64() uint64 {
+	if d.err != nil || len(d.buf) < 8 { //nolint:gomnd
+		d.err = xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+
+		return 0
+	}
+	res := binary.LittleEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+
+	return res
+}
+
+func (d *diskSpoolDecoder) bytes() []byte {
+	size := d.uint32()
+	if d.err != nil || uint64(len(d.buf)) < uint64(size) {
+		d.err = xerrors.WithStackTrace(errDiskSpoolCorruptedRecord)
+
+		return nil
+	}
+	res := d.buf[:size:size]
+	d.buf = d.buf[size:]
+
+	return res
+}
@@ -0,0 +1,281 @@
+package topicwriterinternal
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+)
+
+func TestDiskSpool(t *testing.T) {
+	replayAll := func(t *testing.T, s *diskSpool, afterSeqNo int64) []diskSpoolRecord {
+		var res []diskSpoolRecord
+		require.NoError(t, s.Replay(afterSeqNo, func(rec diskSpoolRecord) error {
+			res = append(res, rec)
+
+			return nil
+		}))
+
+		return res
+	}
+	segmentFiles := func(t *testing.T, dir string) []string {
+		files, err := filepath.Glob(filepath.Join(dir, "*"+diskSpoolSegmentExt))
+		require.NoError(t, err)
+
+		return files
+	}
+
+	t.Run("AppendAndReplay", func(t *testing.T) {
+		dir := t.TempDir()
+		createdAt := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		s, err := openDiskSpool(dir, "test/producer", 0)
+		require.NoError(t, err)
+		require.Empty(t, replayAll(t, s, 0))
+
+		raw := newTestDiskSpoolMessage(t, 1, "raw", rawtopiccommon.CodecRaw)
+		raw.CreatedAt = createdAt
+		raw.Metadata = map[string][]byte{"key": []byte("val")}
+		gzipped := newTestDiskSpoolMessage(t, 2, "gzipped", rawtopiccommon.CodecGzip)
+		require.NoError(t, s.Append([]messageWithDataContent{raw, gzipped}))
+		require.NoError(t, s.Close())
+
+		s, err = openDiskSpool(dir, "test/producer", 0)
+		require.NoError(t, err)
+		records := replayAll(t, s, 0)
+		require.Len(t, records, 2)
+
+		require.Equal(t, int64(1), records[0].SeqNo)
+		require.True(t, createdAt.Equal(records[0].CreatedAt))
+		require.Equal(t, map[string][]byte{"key": []byte("val")}, records[0].Metadata)
+		require.Equal(t, rawtopiccommon.CodecRaw, records[0].Codec)
+		require.Equal(t, 3, records[0].UncompressedSize)
+		require.Equal(t, []byte("raw"), records[0].Data)
+
+		mess := records[1].message(testCommonEncoders)
+		require.Equal(t, int64(2), mess.SeqNo)
+		require.True(t, mess.CreatedAt.IsZero())
+		require.Equal(t, len("gzipped"), mess.BufUncompressedSize)
+		data, err := mess.GetEncodedBytes(rawtopiccommon.CodecGzip)
+		require.NoError(t, err)
+		require.Equal(t, gzipped.bufEncoded.Bytes(), data)
+
+		require.Len(t, replayAll(t, s, 1), 1)
+	})
+	t.Run("BrokenTail", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := openDiskSpool(dir, "producer", 0)
+		require.NoError(t, err)
+		require.Empty(t, replayAll(t, s, 0))
+		require.NoError(t, s.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 1, "1", rawtopiccommon.CodecRaw),
+			newTestDiskSpoolMessage(t, 2, "2", rawtopiccommon.CodecRaw),
+		}))
+		require.NoError(t, s.Close())
+
+		files := segmentFiles(t, filepath.Join(dir, "producer"))
+		require.Len(t, files, 1)
+		content, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(files[0], content[:len(content)-1], 0o600))
+
+		s, err = openDiskSpool(dir, "producer", 0)
+		require.NoError(t, err)
+		records := replayAll(t, s, 0)
+		require.Len(t, records, 1)
+		require.Equal(t, int64(1), records[0].SeqNo)
+		require.NoError(t, s.Close())
+
+		// the broken tail is truncated, so the segment stays valid after appends to newer segments
+		s, err = openDiskSpool(dir, "producer", 0)
+		require.NoError(t, err)
+		require.Len(t, replayAll(t, s, 0), 1)
+		require.NoError(t, s.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 2, "2", rawtopiccommon.CodecRaw),
+		}))
+		require.NoError(t, s.Close())
+
+		s, err = openDiskSpool(dir, "producer", 0)
+		require.NoError(t, err)
+		require.Len(t, replayAll(t, s, 0), 2)
+	})
+	t.Run("CorruptedOlderSegment", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		require.Empty(t, replayAll(t, s, 0))
+		for seqNo := int64(1); seqNo <= 2; seqNo++ {
+			require.NoError(t, s.Append([]messageWithDataContent{
+				newTestDiskSpoolMessage(t, seqNo, "data", rawtopiccommon.CodecRaw),
+			}))
+		}
+		require.NoError(t, s.Close())
+
+		files := segmentFiles(t, filepath.Join(dir, "producer"))
+		require.Len(t, files, 2)
+		content, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		content[len(content)-1] ^= 0xFF
+		require.NoError(t, os.WriteFile(files[0], content, 0o600))
+
+		s, err = openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		err = s.Replay(0, func(rec diskSpoolRecord) error {
+			return nil
+		})
+		require.ErrorIs(t, err, errDiskSpoolCorruptedRecord)
+	})
+	t.Run("ShortWrite", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		require.Empty(t, replayAll(t, s, 0))
+
+		// second segment gets a short write of half of the record
+		testErr := errors.New("no space left on device")
+		segments := 0
+		createFile := s.createFile
+		s.createFile = func(path string) (diskSpoolFile, error) {
+			f, err := createFile(path)
+			segments++
+			if err != nil || segments != 2 {
+				return f, err
+			}
+
+			return &testShortWriteFile{diskSpoolFile: f, err: testErr}, nil
+		}
+
+		require.NoError(t, s.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 1, "1", rawtopiccommon.CodecRaw),
+		}))
+		require.ErrorIs(t, s.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 2, "2", rawtopiccommon.CodecRaw),
+		}), testErr)
+		for seqNo := int64(3); seqNo <= 4; seqNo++ {
+			require.NoError(t, s.Append([]messageWithDataContent{
+				newTestDiskSpoolMessage(t, seqNo, strconv.FormatInt(seqNo, 10), rawtopiccommon.CodecRaw),
+			}))
+		}
+		require.NoError(t, s.Close())
+		require.Len(t, segmentFiles(t, filepath.Join(dir, "producer")), 3)
+
+		s, err = openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		records := replayAll(t, s, 0)
+		require.Len(t, records, 3)
+		for i, seqNo := range []int64{1, 3, 4} {
+			require.Equal(t, seqNo, records[i].SeqNo)
+		}
+	})
+	t.Run("BadCRC", func(t *testing.T) {
+		rec := diskSpoolRecord{SeqNo: 1, Data: []byte("data")}
+		content := rec.appendTo(nil)
+		content = rec.appendTo(content)
+		content[len(content)-1] ^= 0xFF
+
+		records, validSize, err := decodeDiskSpoolRecords(content)
+		require.ErrorIs(t, err, errDiskSpoolCorruptedRecord)
+		require.Len(t, records, 1)
+		require.Equal(t, len(content)/2, validSize)
+	})
+	t.Run("AckRemovesSegments", func(t *testing.T) {
+		dir := t.TempDir()
+		spoolDir := filepath.Join(dir, "producer")
+		s, err := openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		require.Empty(t, replayAll(t, s, 0))
+
+		for seqNo := int64(1); seqNo <= 3; seqNo++ {
+			require.NoError(t, s.Append([]messageWithDataContent{
+				newTestDiskSpoolMessage(t, seqNo, "data", rawtopiccommon.CodecRaw),
+			}))
+		}
+		require.Len(t, segmentFiles(t, spoolDir), 3)
+
+		require.NoError(t, s.Ack(2))
+		require.Len(t, segmentFiles(t, spoolDir), 1)
+
+		require.NoError(t, s.Ack(3))
+		require.Empty(t, segmentFiles(t, spoolDir))
+
+		require.NoError(t, s.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 4, "data", rawtopiccommon.CodecRaw),
+		}))
+		require.Len(t, segmentFiles(t, spoolDir), 1)
+		require.NoError(t, s.Close())
+	})
+	t.Run("ReplayRemovesWrittenSegments", func(t *testing.T) {
+		dir := t.TempDir()
+		spoolDir := filepath.Join(dir, "producer")
+		s, err := openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		require.Empty(t, replayAll(t, s, 0))
+		for seqNo := int64(1); seqNo <= 3; seqNo++ {
+			require.NoError(t, s.Append([]messageWithDataContent{
+				newTestDiskSpoolMessage(t, seqNo, "data", rawtopiccommon.CodecRaw),
+			}))
+		}
+		require.NoError(t, s.Close())
+
+		s, err = openDiskSpool(dir, "producer", 1)
+		require.NoError(t, err)
+		records := replayAll(t, s, 2)
+		require.Len(t, records, 1)
+		require.Equal(t, int64(3), records[0].SeqNo)
+		require.Len(t, segmentFiles(t, spoolDir), 1)
+
+		// segment with replayed messages must not be removed before ack
+		require.NoError(t, s.Ack(2))
+		require.Len(t, segmentFiles(t, spoolDir), 1)
+		require.NoError(t, s.Ack(3))
+		require.Empty(t, segmentFiles(t, spoolDir))
+	})
+	t.Run("AppendAfterClose", func(t *testing.T) {
+		s, err := openDiskSpool(t.TempDir(), "producer", 0)
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+		require.ErrorIs(t, s.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 1, "data", rawtopiccommon.CodecRaw),
+		}), errDiskSpoolClosed)
+	})
+}
+
+func newTestDiskSpoolMessage(
+	t testing.TB,
+	seqNo int64,
+	data string,
+	codec rawtopiccommon.Codec,
+) messageWithDataContent {
+	mess := newMessageDataWithContent(PublicMessage{
+		SeqNo: seqNo,
+		Data:  bytes.NewReader([]byte(data)),
+	}, testCommonEncoders)
+	require.NoError(t, mess.CacheMessageData(codec))
+
+	return mess
+}
+
+// testShortWriteFile writes half of the first written buffer and fails
+type testShortWriteFile struct {
+	diskSpoolFile
+
+	err    error
+	failed bool
+}
+
+func (f *testShortWriteFile) Write(p []byte) (int, error) {
+	if f.failed {
+		return f.diskSpoolFile.Write(p)
+	}
+	f.failed = true
+	n, _ := f.diskSpoolFile.Write(p[:len(p)/2])
+
+	return n, f.err
+}
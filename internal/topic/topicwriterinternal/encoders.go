@@ -2,16 +2,20 @@ package topicwriterinternal
 
 import (
 	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+var errZstdEncoderClosed = xerrors.Wrap(errors.New("ydb: write to closed zstd encoder"))
+
 const (
 	codecMeasureIntervalBatches = 100
 	codecUnknown                = rawtopiccommon.CodecUNSPECIFIED
@@ -30,6 +34,7 @@ func NewEncoderMap() *EncoderMap {
 			rawtopiccommon.CodecGzip: func(writer io.Writer) (io.WriteCloser, error) {
 				return gzip.NewWriter(writer), nil
 			},
+			rawtopiccommon.CodecZstd: newZstdEncoder,
 		},
 	}
 }
@@ -71,6 +76,50 @@ func (nopWriteCloser) Close() error {
 	return nil
 }
 
+// zstdEncoderPool reuse zstd encoders, because create of the encoder is expensive
+var zstdEncoderPool sync.Pool
+
+// zstdEncoder return encoder to pool after close
+type zstdEncoder struct {
+	encoder *zstd.Encoder
+}
+
+func newZstdEncoder(writer io.Writer) (io.WriteCloser, error) {
+	if encoder, ok := zstdEncoderPool.Get().(*zstd.Encoder); ok {
+		encoder.Reset(writer)
+
+		return &zstdEncoder{encoder: encoder}, nil
+	}
+
+	encoder, err := zstd.NewWriter(writer, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &zstdEncoder{encoder: encoder}, nil
+}
+
+func (e *zstdEncoder) Write(p []byte) (int, error) {
+	if e.encoder == nil {
+		return 0, xerrors.WithStackTrace(errZstdEncoderClosed)
+	}
+
+	return e.encoder.Write(p)
+}
+
+func (e *zstdEncoder) Close() error {
+	if e.encoder == nil {
+		return nil
+	}
+
+	err := e.encoder.Close()
+	e.encoder.Reset(nil)
+	zstdEncoderPool.Put(e.encoder)
+	e.encoder = nil
+
+	return err
+}
+
 // EncoderSelector not thread safe
 type EncoderSelector struct {
 	m *EncoderMap
@@ -2,9 +2,12 @@ package topicwriterinternal
 
 import (
 	"bytes"
+	"io"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
@@ -205,4 +208,52 @@ func TestCompressMessages(t *testing.T) {
 
 		require.Error(t, cacheMessages(messages, rawtopiccommon.CodecGzip, parallelCount))
 	})
+
+	t.Run("ZstdOk", func(t *testing.T) {
+		var messages []messageWithDataContent
+		for i := 0; i < messageCount; i++ {
+			data := strings.Repeat(strconv.Itoa(i), 100)
+			mess := newMessageDataWithContent(PublicMessage{Data: strings.NewReader(data)}, testCommonEncoders)
+			messages = append(messages, mess)
+		}
+
+		require.NoError(t, cacheMessages(messages, rawtopiccommon.CodecZstd, parallelCount))
+
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer decoder.Close()
+
+		for i := 0; i < messageCount; i++ {
+			require.Equal(t, rawtopiccommon.CodecZstd, messages[i].bufCodec)
+
+			content, err := decoder.DecodeAll(messages[i].bufEncoded.Bytes(), nil)
+			require.NoError(t, err)
+			require.Equal(t, strings.Repeat(strconv.Itoa(i), 100), string(content))
+		}
+	})
+}
+
+func TestZstdEncoder(t *testing.T) {
+	// encoders reused through pool, every encoded frame must be independent
+	for i := 0; i < 3; i++ {
+		buf := &bytes.Buffer{}
+		encoder, err := NewEncoderMap().CreateLazyEncodeWriter(rawtopiccommon.CodecZstd, buf)
+		require.NoError(t, err)
+
+		data := strings.Repeat("test", i+1)
+		_, err = encoder.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, encoder.Close())
+		require.NoError(t, encoder.Close())
+
+		_, err = encoder.Write([]byte(data))
+		require.ErrorIs(t, err, errZstdEncoderClosed)
+
+		decoder, err := zstd.NewReader(buf)
+		require.NoError(t, err)
+		content, err := io.ReadAll(decoder)
+		decoder.Close()
+		require.NoError(t, err)
+		require.Equal(t, data, string(content))
+	}
 }
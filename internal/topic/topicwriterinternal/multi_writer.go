@@ -0,0 +1,259 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+var (
+	errMultiWriterClosed           = xerrors.Wrap(errors.New("ydb: multi writer closed"))
+	errMultiWriterNoDescribeTopic  = xerrors.Wrap(errors.New("ydb: multi writer need describe topic function"))
+	errMultiWriterNoTopicPartition = xerrors.Wrap(errors.New("ydb: topic has no active partitions for multi writer"))
+)
+
+// PublicMultiWriterMessage is a message of MultiWriter with route to partition of the topic.
+// Message routes to partition explicitly set by WithPartitionID or by hash of Key.
+// Messages without key and partition distributed between partitions in turn.
+type PublicMultiWriterMessage struct {
+	PublicMessage
+
+	// Key of the message, messages with same key are written to same partition in order of write
+	Key string
+
+	partitionID    int64
+	hasPartitionID bool
+}
+
+// WithPartitionID return copy of the message with explicit partition of the message, it overrides routing by Key
+func (m PublicMultiWriterMessage) WithPartitionID(partitionID int64) PublicMultiWriterMessage {
+	m.partitionID = partitionID
+	m.hasPartitionID = true
+
+	return m
+}
+
+// MultiWriter writes messages to many partitions of the topic, it has own write stream for every partition
+type MultiWriter struct {
+	cfg            WriterReconnectorConfig
+	options        []PublicWriterOption
+	queueSemaphore *semaphore.Weighted
+
+	m                  xsync.Mutex
+	writers            map[int64]*WriterReconnector
+	partitions         []int64 // active partitions of the topic sorted by id, used for route messages by key
+	nextPartitionIndex int
+	closed             bool
+
+	newWriter func(options []PublicWriterOption) *WriterReconnector
+}
+
+func NewMultiWriter(cred credentials.Credentials, options []PublicWriterOption) (*MultiWriter, error) {
+	options = append(
+		options,
+		WithCredentials(cred),
+	)
+	cfg := newWriterReconnectorConfig(options...)
+	if cfg.DescribeTopic == nil {
+		return nil, xerrors.WithStackTrace(errMultiWriterNoDescribeTopic)
+	}
+	if cfg.diskSpoolDir != "" {
+		return nil, xerrors.WithStackTrace(errDiskSpoolNotSupported)
+	}
+
+	return &MultiWriter{
+		cfg:            cfg,
+		options:        options,
+		queueSemaphore: semaphore.NewWeighted(int64(cfg.MaxQueueLen)),
+		writers:        make(map[int64]*WriterReconnector),
+		newWriter: func(options []PublicWriterOption) *WriterReconnector {
+			return newWriterReconnector(newWriterReconnectorConfig(options...))
+		},
+	}, nil
+}
+
+// Write routes messages to partitions and writes them to partition writers.
+// If write to one of the partitions failed - messages to other partitions can be written.
+func (w *MultiWriter) Write(ctx context.Context, messages ...PublicMultiWriterMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	byPartition, err := w.routeMessages(ctx, messages)
+	if err != nil {
+		return err
+	}
+
+	writers := make(map[int64]*WriterReconnector, len(byPartition))
+	w.m.WithLock(func() {
+		if w.closed {
+			err = xerrors.WithStackTrace(errMultiWriterClosed)
+
+			return
+		}
+		for partitionID := range byPartition {
+			writers[partitionID] = w.partitionWriterNeedLock(partitionID)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var g errgroup.Group
+	for partitionID, partitionMessages := range byPartition {
+		writer, partitionMessages := writers[partitionID], partitionMessages
+		g.Go(func() error {
+			return writer.Write(ctx, partitionMessages)
+		})
+	}
+
+	return g.Wait()
+}
+
+// Flush waits till all in-flight messages of all partitions are acknowledged
+func (w *MultiWriter) Flush(ctx context.Context) error {
+	return w.forAllWriters(func(writer *WriterReconnector) error {
+		return writer.Flush(ctx)
+	})
+}
+
+// Close flushes rest messages and closes writers of all partitions
+func (w *MultiWriter) Close(ctx context.Context) error {
+	var alreadyClosed bool
+	w.m.WithLock(func() {
+		alreadyClosed = w.closed
+		w.closed = true
+	})
+	if alreadyClosed {
+		return xerrors.WithStackTrace(errMultiWriterClosed)
+	}
+
+	return w.forAllWriters(func(writer *WriterReconnector) error {
+		return writer.Close(ctx)
+	})
+}
+
+func (w *MultiWriter) forAllWriters(f func(writer *WriterReconnector) error) error {
+	var writers []*WriterReconnector
+	w.m.WithLock(func() {
+		writers = make([]*WriterReconnector, 0, len(w.writers))
+		for _, writer := range w.writers {
+			writers = append(writers, writer)
+		}
+	})
+
+	var g errgroup.Group
+	for _, writer := range writers {
+		writer := writer
+		g.Go(func() error {
+			return f(writer)
+		})
+	}
+
+	return g.Wait()
+}
+
+func (w *MultiWriter) routeMessages(
+	ctx context.Context,
+	messages []PublicMultiWriterMessage,
+) (map[int64][]PublicMessage, error) {
+	if err := w.initPartitions(ctx); err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64][]PublicMessage)
+	w.m.WithLock(func() {
+		for i := range messages {
+			partitionID := w.messagePartitionNeedLock(&messages[i])
+			res[partitionID] = append(res[partitionID], messages[i].PublicMessage)
+		}
+	})
+
+	return res, nil
+}
+
+func (w *MultiWriter) messagePartitionNeedLock(message *PublicMultiWriterMessage) int64 {
+	if message.hasPartitionID {
+		return message.partitionID
+	}
+
+	if message.Key != "" {
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(message.Key))
+
+		return w.partitions[hash.Sum64()%uint64(len(w.partitions))]
+	}
+
+	partitionID := w.partitions[w.nextPartitionIndex]
+	w.nextPartitionIndex = (w.nextPartitionIndex + 1) % len(w.partitions)
+
+	return partitionID
+}
+
+// initPartitions load active partitions of the topic once, describe retried on next write if failed
+func (w *MultiWriter) initPartitions(ctx context.Context) error {
+	var initialized bool
+	w.m.WithLock(func() {
+		initialized = w.partitions != nil
+	})
+	if initialized {
+		return nil
+	}
+
+	description, err := w.cfg.DescribeTopic(ctx, w.cfg.topic)
+	if err != nil {
+		return err
+	}
+
+	partitions := make([]int64, 0, len(description.Partitions))
+	for i := range description.Partitions {
+		if description.Partitions[i].Active {
+			partitions = append(partitions, description.Partitions[i].PartitionID)
+		}
+	}
+	if len(partitions) == 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %q", errMultiWriterNoTopicPartition, w.cfg.topic))
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i] < partitions[j]
+	})
+
+	w.m.WithLock(func() {
+		if w.partitions == nil {
+			w.partitions = partitions
+		}
+	})
+
+	return nil
+}
+
+func (w *MultiWriter) partitionWriterNeedLock(partitionID int64) *WriterReconnector {
+	if writer, ok := w.writers[partitionID]; ok {
+		return writer
+	}
+
+	options := make([]PublicWriterOption, 0, len(w.options)+3) //nolint:gomnd
+	options = append(options, w.options...)
+	options = append(options,
+		WithProducerID(fmt.Sprintf("%s-%d", w.cfg.producerID, partitionID)),
+		WithPartitioning(NewPartitioningWithPartitionID(partitionID)),
+		withQueueSemaphore(w.queueSemaphore),
+	)
+
+	writer := w.newWriter(options)
+	w.writers[partitionID] = writer
+
+	return writer
+}
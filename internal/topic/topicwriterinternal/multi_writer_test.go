@@ -0,0 +1,160 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+func newTestMultiWriter(t testing.TB, describe DescribeTopicFunc) *MultiWriter {
+	w, err := NewMultiWriter(credentials.NewAnonymousCredentials(), []PublicWriterOption{
+		WithTopic("/test"),
+		WithProducerID("producer"),
+		WithMaxQueueLen(10),
+		WithDescribeTopicFunc(describe),
+	})
+	require.NoError(t, err)
+	w.newWriter = func(options []PublicWriterOption) *WriterReconnector {
+		return newWriterReconnectorStopped(newWriterReconnectorConfig(options...))
+	}
+
+	return w
+}
+
+func describeTopicWithoutPartitions(ctx context.Context, topicPath string) (topictypes.TopicDescription, error) {
+	return topictypes.TopicDescription{}, nil
+}
+
+func TestMultiWriter_RouteMessages(t *testing.T) {
+	describeCalls := 0
+	describe := func(ctx context.Context, topicPath string) (topictypes.TopicDescription, error) {
+		describeCalls++
+
+		return topictypes.TopicDescription{
+			Partitions: []topictypes.PartitionInfo{
+				{PartitionID: 3, Active: true},
+				{PartitionID: 1, Active: false, ChildPartitionIDs: []int64{2, 3}},
+				{PartitionID: 2, Active: true},
+			},
+		}, nil
+	}
+
+	t.Run("ByKey", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		w := newTestMultiWriter(t, describe)
+
+		res, err := w.routeMessages(ctx, []PublicMultiWriterMessage{
+			{Key: "a", PublicMessage: PublicMessage{SeqNo: 1}},
+			{Key: "b", PublicMessage: PublicMessage{SeqNo: 2}},
+			{Key: "a", PublicMessage: PublicMessage{SeqNo: 3}},
+		})
+		require.NoError(t, err)
+
+		for partitionID, messages := range res {
+			require.Contains(t, []int64{2, 3}, partitionID)
+			for i := 1; i < len(messages); i++ {
+				require.Less(t, messages[i-1].SeqNo, messages[i].SeqNo, "order of messages must be saved")
+			}
+		}
+
+		// same key routed to same partition on next writes
+		first, err := w.routeMessages(ctx, []PublicMultiWriterMessage{{Key: "a"}})
+		require.NoError(t, err)
+		second, err := w.routeMessages(ctx, []PublicMultiWriterMessage{{Key: "a"}})
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+	})
+	t.Run("ExplicitPartition", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		w := newTestMultiWriter(t, describe)
+
+		res, err := w.routeMessages(ctx, []PublicMultiWriterMessage{
+			PublicMultiWriterMessage{Key: "a"}.WithPartitionID(5),
+		})
+		require.NoError(t, err)
+		require.Len(t, res[5], 1)
+	})
+	t.Run("RoundRobinWithoutKey", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		w := newTestMultiWriter(t, describe)
+
+		res, err := w.routeMessages(ctx, []PublicMultiWriterMessage{{}, {}, {}})
+		require.NoError(t, err)
+		require.Len(t, res[2], 2)
+		require.Len(t, res[3], 1)
+	})
+	t.Run("DescribeOnce", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		describeCalls = 0
+		w := newTestMultiWriter(t, describe)
+
+		for i := 0; i < 3; i++ {
+			_, err := w.routeMessages(ctx, []PublicMultiWriterMessage{{Key: "a"}})
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, describeCalls)
+	})
+	t.Run("DescribeError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		testErr := errors.New("test")
+		w := newTestMultiWriter(t, func(ctx context.Context, topicPath string) (topictypes.TopicDescription, error) {
+			return topictypes.TopicDescription{}, testErr
+		})
+
+		err := w.Write(ctx, PublicMultiWriterMessage{Key: "a"})
+		require.ErrorIs(t, err, testErr)
+	})
+	t.Run("NoActivePartitions", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		w := newTestMultiWriter(t, describeTopicWithoutPartitions)
+
+		err := w.Write(ctx, PublicMultiWriterMessage{Key: "a"})
+		require.ErrorIs(t, err, errMultiWriterNoTopicPartition)
+	})
+}
+
+func TestMultiWriter_PartitionWriter(t *testing.T) {
+	w := newTestMultiWriter(t, describeTopicWithoutPartitions)
+
+	var first, second, other *WriterReconnector
+	w.m.WithLock(func() {
+		first = w.partitionWriterNeedLock(1)
+		second = w.partitionWriterNeedLock(1)
+		other = w.partitionWriterNeedLock(2)
+	})
+
+	require.Same(t, first, second)
+	require.NotSame(t, first, other)
+
+	require.Equal(t, "producer-1", first.cfg.producerID)
+	require.Equal(t, rawtopicwriter.NewPartitioningPartitionID(1), first.cfg.defaultPartitioning)
+	require.Equal(t, "producer-2", other.cfg.producerID)
+	require.Equal(t, rawtopicwriter.NewPartitioningPartitionID(2), other.cfg.defaultPartitioning)
+
+	// queue limit shared between partitions
+	require.Same(t, w.queueSemaphore, first.semaphore)
+	require.Same(t, w.queueSemaphore, other.semaphore)
+}
+
+func TestMultiWriter_Close(t *testing.T) {
+	ctx := xtest.Context(t)
+	w := newTestMultiWriter(t, describeTopicWithoutPartitions)
+
+	require.NoError(t, w.Close(ctx))
+	require.ErrorIs(t, w.Close(ctx), errMultiWriterClosed)
+
+	w.partitions = []int64{1}
+	require.ErrorIs(t, w.Write(ctx, PublicMultiWriterMessage{Key: "a"}), errMultiWriterClosed)
+}
+
+func TestNewMultiWriter_WithoutDescribe(t *testing.T) {
+	_, err := NewMultiWriter(credentials.NewAnonymousCredentials(), []PublicWriterOption{WithTopic("/test")})
+	require.ErrorIs(t, err, errMultiWriterNoDescribeTopic)
+}
@@ -32,6 +32,9 @@ const (
 type messageQueue struct {
 	OnAckReceived func(count int)
 
+	// OnAckSeqNoReceived called with max seqno of received acks
+	OnAckSeqNoReceived func(seqNo int64)
+
 	hasNewMessages    empty.Chan
 	closedErr         error
 	acksReceivedEvent xsync.EventBroadcast
@@ -148,6 +151,7 @@ func (q *messageQueue) addMessageNeedLock(
 
 func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	ackReceivedCounter := 0
+	maxAckSeqNo := int64(-1)
 	q.m.Lock()
 	defer func() {
 		q.m.Unlock()
@@ -155,6 +159,9 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 		if q.OnAckReceived != nil {
 			q.OnAckReceived(ackReceivedCounter)
 		}
+		if q.OnAckSeqNoReceived != nil && ackReceivedCounter > 0 {
+			q.OnAckSeqNoReceived(maxAckSeqNo)
+		}
 	}()
 	if q.closed {
 		return xerrors.WithStackTrace(errAckOnClosedMessageQueue)
@@ -165,6 +172,9 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 			return err
 		}
 		ackReceivedCounter++
+		if acks[i].SeqNo > maxAckSeqNo {
+			maxAckSeqNo = acks[i].SeqNo
+		}
 	}
 
 	q.acksReceivedEvent.Broadcast()
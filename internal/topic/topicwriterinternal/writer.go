@@ -32,7 +32,11 @@ func NewWriter(cred credentials.Credentials, options []PublicWriterOption) (*Wri
 		return nil, err
 	}
 
-	writerImpl := newWriterReconnector(cfg)
+	writerImpl := newWriterReconnectorStopped(cfg)
+	if err := writerImpl.openDiskSpool(); err != nil {
+		return nil, err
+	}
+	writerImpl.start()
 
 	return &Writer{
 		streamWriter: writerImpl,
@@ -8,6 +8,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -23,4 +24,18 @@ type WritersCommonConfig struct {
 	credUpdateInterval time.Duration
 	clock              clockwork.Clock
 	forceCodec         rawtopiccommon.Codec
+
+	// tx is a transaction which the written messages are bound to, nil for non-transactional writer
+	tx tx.Transaction
+}
+
+func (cfg *WritersCommonConfig) txIdentity() *rawtopiccommon.TransactionIdentity {
+	if cfg.tx == nil {
+		return nil
+	}
+
+	return &rawtopiccommon.TransactionIdentity{
+		ID:      cfg.tx.ID(),
+		Session: cfg.tx.SessionID(),
+	}
 }
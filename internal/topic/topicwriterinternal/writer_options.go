@@ -4,11 +4,13 @@ import (
 	"time"
 
 	"github.com/jonboulle/clockwork"
+	"golang.org/x/sync/semaphore"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -23,6 +25,14 @@ func WithAddEncoder(codec rawtopiccommon.Codec, encoderFunc PublicCreateEncoderF
 	}
 }
 
+// WithTransaction binds written messages to the transaction.
+// Messages become visible for readers only after commit of the transaction
+func WithTransaction(transaction tx.Transaction) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.tx = transaction
+	}
+}
+
 func WithAutoSetSeqNo(val bool) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.AutoSetSeqNo = val
@@ -83,6 +93,14 @@ func WithConnectFunc(connect ConnectFunc) PublicWriterOption {
 	}
 }
 
+// WithDescribeTopicFunc set function for describe topic, it used by writer with fixed partition
+// for follow to child partitions after split of the partition
+func WithDescribeTopicFunc(describe DescribeTopicFunc) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.DescribeTopic = describe
+	}
+}
+
 func WithConnectTimeout(timeout time.Duration) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.connectTimeout = timeout
@@ -101,6 +119,12 @@ func WithMaxQueueLen(num int) PublicWriterOption {
 	}
 }
 
+func withQueueSemaphore(queueSemaphore *semaphore.Weighted) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.queueSemaphore = queueSemaphore
+	}
+}
+
 func WithPartitioning(partitioning PublicFuturePartitioning) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.defaultPartitioning = partitioning.ToRaw()
@@ -155,6 +179,13 @@ func WithTopic(topic string) PublicWriterOption {
 	}
 }
 
+// WithDiskSpool enable write-ahead log of messages in the dir
+func WithDiskSpool(dir string) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.diskSpoolDir = dir
+	}
+}
+
 // WithClock is private option for tests
 func WithClock(clock clockwork.Clock) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"golang.org/x/sync/semaphore"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
@@ -37,6 +38,8 @@ var (
 	errNonZeroCreatedAt      = xerrors.Wrap(errors.New("ydb: non zero Message.CreatedAt and set auto fill created at option")) //nolint:lll
 	errNoAllowedCodecs       = xerrors.Wrap(errors.New("ydb: no allowed codecs for write to topic"))
 	errLargeMessage          = xerrors.Wrap(errors.New("ydb: message uncompressed size more, then limit"))
+	errDiskSpoolNoProducerID = xerrors.Wrap(errors.New("ydb: disk spool of topic writer requires explicit producer id"))
+	errDiskSpoolNotSupported = xerrors.Wrap(errors.New("ydb: disk spool supported by simple topic writer only"))
 	PublicErrQueueIsFull     = xerrors.Wrap(errors.New("ydb: queue is full"))
 
 	// errProducerIDNotEqualMessageGroupID is temporary
@@ -55,6 +58,7 @@ type WriterReconnectorConfig struct {
 	Common                       config.Common
 	AdditionalEncoders           map[rawtopiccommon.Codec]PublicCreateEncoderFunc
 	Connect                      ConnectFunc
+	DescribeTopic                DescribeTopicFunc
 	WaitServerAck                bool
 	AutoSetSeqNo                 bool
 	AutoSetCreatedTime           bool
@@ -62,6 +66,15 @@ type WriterReconnectorConfig struct {
 	RetrySettings                topic.RetrySettings
 
 	connectTimeout time.Duration
+
+	// randomProducerID is true if producer id generated by writer because it was not set by options
+	randomProducerID bool
+
+	// diskSpoolDir is base dir for write-ahead log of messages, empty for writer without disk spool
+	diskSpoolDir string
+
+	// queueSemaphore limits messages in queue, it shared between writers of MultiWriter. Created by writer if nil.
+	queueSemaphore *semaphore.Weighted
 }
 
 func (cfg *WriterReconnectorConfig) validate() error {
@@ -70,6 +83,11 @@ func (cfg *WriterReconnectorConfig) validate() error {
 		return xerrors.WithStackTrace(errProducerIDNotEqualMessageGroupID)
 	}
 
+	// replay of messages from disk spool after restart needs same producer id for deduplicate messages on server
+	if cfg.diskSpoolDir != "" && cfg.randomProducerID {
+		return xerrors.WithStackTrace(errDiskSpoolNoProducerID)
+	}
+
 	return nil
 }
 
@@ -108,6 +126,7 @@ func newWriterReconnectorConfig(options ...PublicWriterOption) WriterReconnector
 
 	if cfg.producerID == "" {
 		WithProducerID(uuid.NewString())(&cfg)
+		cfg.randomProducerID = true
 	}
 
 	return cfg
@@ -129,6 +148,7 @@ type WriterReconnector struct {
 	m                              xsync.RWMutex
 	firstConnectionHandled         atomic.Bool
 	initDone                       bool
+	spool                          *diskSpool
 }
 
 func newWriterReconnector(
@@ -144,9 +164,13 @@ func newWriterReconnectorStopped(
 	cfg WriterReconnectorConfig, //nolint:gocritic
 ) *WriterReconnector {
 	writerInstanceID, _ := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	queueSemaphore := cfg.queueSemaphore
+	if queueSemaphore == nil {
+		queueSemaphore = semaphore.NewWeighted(int64(cfg.MaxQueueLen))
+	}
 	res := &WriterReconnector{
 		cfg:                            cfg,
-		semaphore:                      semaphore.NewWeighted(int64(cfg.MaxQueueLen)),
+		semaphore:                      queueSemaphore,
 		queue:                          newMessageQueue(),
 		lastSeqNo:                      -1,
 		firstInitResponseProcessedChan: make(empty.Chan),
@@ -199,6 +223,22 @@ func (w *WriterReconnector) fillFields(messages []messageWithDataContent) error
 	return nil
 }
 
+// openDiskSpool open disk spool if it enabled in config, it must be called before start
+func (w *WriterReconnector) openDiskSpool() error {
+	if w.cfg.diskSpoolDir == "" {
+		return nil
+	}
+
+	spool, err := openDiskSpool(w.cfg.diskSpoolDir, w.cfg.producerID, diskSpoolDefaultSegmentSize)
+	if err != nil {
+		return err
+	}
+	w.spool = spool
+	w.queue.OnAckSeqNoReceived = w.onAckSeqNoReceived
+
+	return nil
+}
+
 func (w *WriterReconnector) start() {
 	name := fmt.Sprintf("writer %q", w.cfg.topic)
 	w.background.Start(name+", sendloop", w.connectionLoop)
@@ -224,6 +264,13 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			PublicErrQueueIsFull,
 		))
 	}
+	if w.spool != nil {
+		// replay of disk spool takes space of queue before first init response processed,
+		// new messages must not hold the space while wait end of the replay
+		if err := w.waitFirstInitResponse(ctx); err != nil {
+			return err
+		}
+	}
 	if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
 		return xerrors.WithStackTrace(
 			fmt.Errorf("ydb: add new messages exceed max queue size limit. Add count: %v, max size: %v: %w",
@@ -257,6 +304,13 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			return
 		}
 
+		if w.spool != nil {
+			// store messages to disk before the queue for send them after restart of the application
+			if err = w.spool.Append(messagesSlice); err != nil {
+				return
+			}
+		}
+
 		if w.cfg.WaitServerAck {
 			waiter, err = w.queue.AddMessagesWithWaiter(messagesSlice)
 		} else {
@@ -358,6 +412,13 @@ func (w *WriterReconnector) close(ctx context.Context, reason error) (resErr err
 		resErr = closeErr
 	}
 
+	if w.spool != nil {
+		spoolErr := w.spool.Close()
+		if resErr == nil && spoolErr != nil {
+			resErr = spoolErr
+		}
+	}
+
 	return resErr
 }
 
@@ -417,6 +478,10 @@ func (w *WriterReconnector) connectionLoop(ctx context.Context) {
 			}
 		}
 
+		if w.needFollowPartitionSplit(reconnectReason) {
+			w.followPartitionSplit(ctx)
+		}
+
 		writer, err := w.startWriteStream(ctx, streamCtx, attempt)
 		w.onWriterChange(writer)
 		if err == nil {
@@ -428,6 +493,59 @@ func (w *WriterReconnector) connectionLoop(ctx context.Context) {
 	}
 }
 
+// needFollowPartitionSplit check if writer with fixed partition must search new partition for write.
+// Server responses with OVERLOADED status for write to inactive partition, for example after autopartitioning split.
+func (w *WriterReconnector) needFollowPartitionSplit(reconnectReason error) bool {
+	return reconnectReason != nil &&
+		w.cfg.DescribeTopic != nil &&
+		w.cfg.defaultPartitioning.Type == rawtopicwriter.PartitioningPartitionID &&
+		xerrors.IsOperationError(reconnectReason, Ydb.StatusIds_OVERLOADED)
+}
+
+// followPartitionSplit change partition of the writer to active child of current partition if it is inactive.
+// Describe errors ignored because the writer reconnects to the same partition with usual retry policy.
+func (w *WriterReconnector) followPartitionSplit(ctx context.Context) {
+	description, err := w.cfg.DescribeTopic(ctx, w.cfg.topic)
+	if err != nil {
+		return
+	}
+
+	if partitionID, ok := activeDescendantPartition(
+		description.Partitions, w.cfg.defaultPartitioning.PartitionID,
+	); ok {
+		w.cfg.defaultPartitioning = rawtopicwriter.NewPartitioningPartitionID(partitionID)
+	}
+}
+
+// activeDescendantPartition search first active partition through child partitions of inactive partition.
+// Return false if partition is active or has no active descendants.
+func activeDescendantPartition(partitions []topictypes.PartitionInfo, partitionID int64) (int64, bool) {
+	partitionsByID := make(map[int64]*topictypes.PartitionInfo, len(partitions))
+	for i := range partitions {
+		partitionsByID[partitions[i].PartitionID] = &partitions[i]
+	}
+
+	visited := make(map[int64]bool, len(partitions))
+	queue := []int64{partitionID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		partition, ok := partitionsByID[id]
+		if !ok || visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		if partition.Active {
+			return id, id != partitionID
+		}
+		queue = append(queue, partition.ChildPartitionIDs...)
+	}
+
+	return 0, false
+}
+
 func (w *WriterReconnector) startWriteStream(ctx, streamCtx context.Context, attempt int) (
 	writer *SingleStreamWriter,
 	err error,
@@ -504,6 +622,13 @@ func (w *WriterReconnector) onAckReceived(count int) {
 	w.semaphore.Release(int64(count))
 }
 
+// onAckSeqNoReceived remove acked messages from disk spool.
+// Error of remove ignored: the segment will be removed by next ack or replayed after restart
+// and deduplicated by server.
+func (w *WriterReconnector) onAckSeqNoReceived(seqNo int64) {
+	_ = w.spool.Ack(seqNo)
+}
+
 func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 	isFirstInit := false
 	w.m.WithLock(func() {
@@ -517,7 +642,6 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 		if !w.firstConnectionHandled.CompareAndSwap(false, true) {
 			return
 		}
-		defer close(w.firstInitResponseProcessedChan)
 		isFirstInit = true
 
 		if writerStream.LastSeqNumRequested {
@@ -525,14 +649,92 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 		}
 	})
 
-	if isFirstInit {
+	if !isFirstInit {
+		return
+	}
+
+	if w.spool == nil {
+		w.onFirstInitDone(writerStream)
+
+		return
+	}
+
+	// replay can wait acks for free space in queue, it must not block reconnects
+	w.background.Start("replay disk spool", func(ctx context.Context) {
+		if err := w.replayDiskSpool(ctx); err != nil {
+			_ = w.close(ctx, xerrors.WithStackTrace(fmt.Errorf("ydb: failed replay topic writer disk spool: %w", err)))
+
+			return
+		}
+		w.onFirstInitDone(writerStream)
+	})
+}
+
+// replayDiskSpool add messages from disk spool, which was not written to server before restart, to queue.
+// New messages can be added to queue after replay only for keep order of seqno.
+func (w *WriterReconnector) replayDiskSpool(ctx context.Context) error {
+	var serverLastSeqNo int64
+	w.m.WithRLock(func() {
+		serverLastSeqNo = w.lastSeqNo
+	})
+
+	batchSize := diskSpoolReplayBatchSize
+	if batchSize > w.cfg.MaxQueueLen {
+		batchSize = w.cfg.MaxQueueLen
+	}
+
+	batch := make([]messageWithDataContent, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		semaphoreWeight := int64(len(batch))
+		if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
+			return err
+		}
+
+		var err error
 		w.m.WithLock(func() {
-			w.initDone = true
-			w.initInfo = InitialInfo{LastSeqNum: w.lastSeqNo}
-			close(w.initDoneCh)
+			err = w.queue.AddMessages(batch)
+			if err == nil && batch[len(batch)-1].SeqNo > w.lastSeqNo {
+				w.lastSeqNo = batch[len(batch)-1].SeqNo
+			}
 		})
-		w.onWriterInitCallbackHandler(writerStream)
+		if err != nil {
+			w.semaphore.Release(semaphoreWeight)
+
+			return err
+		}
+
+		batch = make([]messageWithDataContent, 0, batchSize)
+
+		return nil
 	}
+
+	err := w.spool.Replay(serverLastSeqNo, func(rec diskSpoolRecord) error {
+		batch = append(batch, rec.message(w.encodersMap))
+		if len(batch) < batchSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+func (w *WriterReconnector) onFirstInitDone(writerStream *SingleStreamWriter) {
+	w.m.WithLock(func() {
+		close(w.firstInitResponseProcessedChan)
+		w.initDone = true
+		w.initInfo = InitialInfo{LastSeqNum: w.lastSeqNo}
+		close(w.initDoneCh)
+	})
+	w.onWriterInitCallbackHandler(writerStream)
 }
 
 func (w *WriterReconnector) WaitInit(ctx context.Context) (info InitialInfo, err error) {
@@ -568,7 +770,8 @@ func (w *WriterReconnector) waitFirstInitResponse(ctx context.Context) error {
 		return err
 	}
 
-	if w.firstConnectionHandled.Load() {
+	// with disk spool first init response processed after replay of the spool only
+	if w.spool == nil && w.firstConnectionHandled.Load() {
 		return nil
 	}
 
@@ -599,6 +802,7 @@ func sendMessagesToStream(
 	stream RawTopicWriterStream,
 	targetCodec rawtopiccommon.Codec,
 	messages []messageWithDataContent,
+	txIdentity *rawtopiccommon.TransactionIdentity,
 ) error {
 	if len(messages) == 0 {
 		return nil
@@ -608,6 +812,7 @@ func sendMessagesToStream(
 	if err != nil {
 		return err
 	}
+	request.Tx = txIdentity
 	err = stream.Send(&request)
 	if err != nil {
 		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed send write request: %w", err))
@@ -732,6 +937,8 @@ func calculateAllowedCodecs(forceCodec rawtopiccommon.Codec, encoderMap *Encoder
 
 type ConnectFunc func(ctx context.Context) (RawTopicWriterStream, error)
 
+type DescribeTopicFunc func(ctx context.Context, topicPath string) (topictypes.TopicDescription, error)
+
 func createPublicCodecsFromRaw(codecs rawtopiccommon.SupportedCodecs) []topictypes.Codec {
 	res := make([]topictypes.Codec, len(codecs))
 	for i, v := range codecs {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"path/filepath"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"go.uber.org/mock/gomock"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
@@ -24,6 +26,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 var testCommonEncoders = NewEncoderMap()
@@ -532,6 +535,188 @@ func TestWriterImpl_Reconnect(t *testing.T) {
 	})
 }
 
+func TestWriterImpl_FollowPartitionSplit(t *testing.T) {
+	overloaded := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+	description := topictypes.TopicDescription{
+		Partitions: []topictypes.PartitionInfo{
+			{PartitionID: 0, Active: false, ChildPartitionIDs: []int64{1, 2}},
+			{PartitionID: 1, Active: false, ChildPartitionIDs: []int64{3}},
+			{PartitionID: 2, Active: true},
+			{PartitionID: 3, Active: true},
+		},
+	}
+
+	newWriter := func(partitionID int64) *WriterReconnector {
+		return newWriterReconnectorStopped(newWriterReconnectorConfig(
+			WithPartitioning(NewPartitioningWithPartitionID(partitionID)),
+			WithDescribeTopicFunc(func(ctx context.Context, topicPath string) (topictypes.TopicDescription, error) {
+				return description, nil
+			}),
+		))
+	}
+
+	t.Run("NeedFollow", func(t *testing.T) {
+		w := newWriter(0)
+		require.True(t, w.needFollowPartitionSplit(xerrors.WithStackTrace(overloaded)))
+		require.False(t, w.needFollowPartitionSplit(nil))
+		require.False(t, w.needFollowPartitionSplit(
+			xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+		))
+
+		w = newWriterReconnectorStopped(newWriterReconnectorConfig(WithProducerID("producer")))
+		require.False(t, w.needFollowPartitionSplit(overloaded))
+	})
+	t.Run("SplitPartition", func(t *testing.T) {
+		w := newWriter(0)
+		w.followPartitionSplit(xtest.Context(t))
+		require.Equal(t, int64(2), w.cfg.defaultPartitioning.PartitionID)
+	})
+	t.Run("SplitChildPartition", func(t *testing.T) {
+		w := newWriter(1)
+		w.followPartitionSplit(xtest.Context(t))
+		require.Equal(t, int64(3), w.cfg.defaultPartitioning.PartitionID)
+	})
+	t.Run("ActivePartition", func(t *testing.T) {
+		w := newWriter(3)
+		w.followPartitionSplit(xtest.Context(t))
+		require.Equal(t, int64(3), w.cfg.defaultPartitioning.PartitionID)
+	})
+	t.Run("UnknownPartition", func(t *testing.T) {
+		w := newWriter(10)
+		w.followPartitionSplit(xtest.Context(t))
+		require.Equal(t, int64(10), w.cfg.defaultPartitioning.PartitionID)
+	})
+}
+
+func TestWriterImpl_DiskSpool(t *testing.T) {
+	t.Run("RequireProducerID", func(t *testing.T) {
+		cfg := newWriterReconnectorConfig(WithDiskSpool(t.TempDir()))
+		require.ErrorIs(t, cfg.validate(), errDiskSpoolNoProducerID)
+
+		cfg = newWriterReconnectorConfig(WithDiskSpool(t.TempDir()), WithProducerID("producer"))
+		require.NoError(t, cfg.validate())
+	})
+	t.Run("ReplayAfterRestart", func(t *testing.T) {
+		dir := t.TempDir()
+		spool, err := openDiskSpool(dir, "test-producer-id", 0)
+		require.NoError(t, err)
+		require.NoError(t, spool.Replay(0, func(rec diskSpoolRecord) error {
+			return nil
+		}))
+		require.NoError(t, spool.Append([]messageWithDataContent{
+			newTestDiskSpoolMessage(t, 1, "1", rawtopiccommon.CodecRaw),
+			newTestDiskSpoolMessage(t, 2, "2", rawtopiccommon.CodecRaw),
+			newTestDiskSpoolMessage(t, 3, "3", rawtopiccommon.CodecRaw),
+		}))
+		require.NoError(t, spool.Close())
+
+		e := newTestEnv(t, &testEnvOptions{
+			writerOptions: []PublicWriterOption{WithDiskSpool(dir)},
+			lastSeqNo:     1,
+			beforeStart: func(e *testEnv) {
+				// replayed messages sent before new messages
+				e.stream.EXPECT().Send(&rawtopicwriter.WriteRequest{
+					Messages: []rawtopicwriter.MessageData{
+						{SeqNo: 2, UncompressedSize: 1, Data: []byte("2")},
+						{SeqNo: 3, UncompressedSize: 1, Data: []byte("3")},
+					},
+					Codec: rawtopiccommon.CodecRaw,
+				}).Do(func(_ interface{}) {
+					written := rawtopicwriter.MessageWriteStatus{Type: rawtopicwriter.WriteStatusTypeWritten}
+					e.sendFromServer(&rawtopicwriter.WriteResult{
+						Acks: []rawtopicwriter.WriteAck{
+							{SeqNo: 2, MessageWriteStatus: written},
+							{SeqNo: 3, MessageWriteStatus: written},
+						},
+						PartitionID: e.partitionID,
+					})
+				}).Return(nil)
+			},
+		})
+
+		info, err := e.writer.WaitInit(e.ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), info.LastSeqNum)
+
+		require.NoError(t, e.writer.Flush(e.ctx))
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			files, err := filepath.Glob(filepath.Join(dir, "test-producer-id", "*"+diskSpoolSegmentExt))
+			require.NoError(t, err)
+
+			return len(files) == 0
+		})
+	})
+	t.Run("ConcurrentWritesDuringReplay", func(t *testing.T) {
+		const (
+			replayCount = 5
+			writesCount = 20
+		)
+
+		dir := t.TempDir()
+		spool, err := openDiskSpool(dir, "test-producer-id", 0)
+		require.NoError(t, err)
+		require.NoError(t, spool.Replay(0, func(rec diskSpoolRecord) error {
+			return nil
+		}))
+		for seqNo := int64(1); seqNo <= replayCount; seqNo++ {
+			require.NoError(t, spool.Append([]messageWithDataContent{
+				newTestDiskSpoolMessage(t, seqNo, "data", rawtopiccommon.CodecRaw),
+			}))
+		}
+		require.NoError(t, spool.Close())
+
+		var (
+			sentM sync.Mutex
+			sent  []int64
+			wg    sync.WaitGroup
+		)
+		e := newTestEnv(t, &testEnvOptions{
+			writerOptions: []PublicWriterOption{
+				WithDiskSpool(dir),
+				WithAutoSetSeqNo(true),
+				// queue is smaller than count of replayed messages, replay waits acks for free space
+				WithMaxQueueLen(2),
+			},
+			beforeStart: func(e *testEnv) {
+				e.stream.EXPECT().Send(gomock.AssignableToTypeOf(&rawtopicwriter.WriteRequest{})).Do(
+					func(req interface{}) {
+						written := rawtopicwriter.MessageWriteStatus{Type: rawtopicwriter.WriteStatusTypeWritten}
+						result := &rawtopicwriter.WriteResult{PartitionID: e.partitionID}
+						sentM.Lock()
+						for _, mess := range req.(*rawtopicwriter.WriteRequest).Messages {
+							sent = append(sent, mess.SeqNo)
+							result.Acks = append(result.Acks, rawtopicwriter.WriteAck{
+								SeqNo:              mess.SeqNo,
+								MessageWriteStatus: written,
+							})
+						}
+						sentM.Unlock()
+						e.sendFromServer(result)
+					}).Return(nil).AnyTimes()
+
+				// writes wait end of replay and must not take queue space needed by replay
+				for i := 0; i < writesCount; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						require.NoError(t, e.writer.Write(e.ctx, []PublicMessage{{Data: bytes.NewReader([]byte("new"))}}))
+					}()
+				}
+			},
+		})
+
+		wg.Wait()
+		require.NoError(t, e.writer.Flush(e.ctx))
+
+		sentM.Lock()
+		defer sentM.Unlock()
+		require.Len(t, sent, replayCount+writesCount)
+		for i := range sent {
+			require.Equal(t, int64(i+1), sent[i])
+		}
+	})
+}
+
 func TestWriterImpl_CloseWithFlush(t *testing.T) {
 	type flushMethod func(ctx context.Context, writer *WriterReconnector) error
 
@@ -855,6 +1040,18 @@ func TestCalculateAllowedCodecs(t *testing.T) {
 				customCodecSupported,
 			},
 		},
+		{
+			name:  "NotForcedWithServerZstd",
+			force: rawtopiccommon.CodecUNSPECIFIED,
+			serverCodecs: rawtopiccommon.SupportedCodecs{
+				rawtopiccommon.CodecRaw,
+				rawtopiccommon.CodecZstd,
+			},
+			expectedResult: rawtopiccommon.SupportedCodecs{
+				rawtopiccommon.CodecRaw,
+				rawtopiccommon.CodecZstd,
+			},
+		},
 	}
 
 	for _, test := range table {
@@ -941,6 +1138,9 @@ type testEnvOptions struct {
 	writerOptions []PublicWriterOption
 	lastSeqNo     int64
 	topicCodecs   rawtopiccommon.SupportedCodecs
+
+	// beforeStart called before start of the writer, for set expectations of messages sent after init
+	beforeStart func(e *testEnv)
 }
 
 func newTestEnv(t testing.TB, options *testEnvOptions) *testEnv {
@@ -970,6 +1170,7 @@ func newTestEnv(t testing.TB, options *testEnvOptions) *testEnv {
 	writerOptions = append(writerOptions, options.writerOptions...)
 
 	res.writer = newWriterReconnectorStopped(newWriterReconnectorConfig(writerOptions...))
+	require.NoError(t, res.writer.openDiskSpool())
 
 	res.stream.EXPECT().Recv().DoAndReturn(res.receiveMessageHandler).AnyTimes()
 
@@ -994,6 +1195,10 @@ func newTestEnv(t testing.TB, options *testEnvOptions) *testEnv {
 		close(streamClosed)
 	})
 
+	if options.beforeStart != nil {
+		options.beforeStart(res)
+	}
+
 	res.writer.start()
 	require.NoError(t, res.writer.waitFirstInitResponse(res.ctx))
 
@@ -239,7 +239,7 @@ func (w *SingleStreamWriter) sendMessagesFromQueueToStreamLoop(ctx context.Conte
 			messages[0].SeqNo,
 			len(messages),
 		)
-		err = sendMessagesToStream(w.cfg.stream, targetCodec, messages)
+		err = sendMessagesToStream(w.cfg.stream, targetCodec, messages, w.cfg.txIdentity())
 		onSentComplete(err)
 		if err != nil {
 			err = xerrors.WithStackTrace(fmt.Errorf("ydb: error send message to topic stream: %w", err))
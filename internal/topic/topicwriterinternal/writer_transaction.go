@@ -0,0 +1,84 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errTransactionCompleted = xerrors.Wrap(errors.New("ydb: transaction of the writer completed"))
+
+// WriterWithTransaction writes messages inside the transaction.
+// The writer flushes messages before commit of the transaction and stops after commit or rollback
+type WriterWithTransaction struct {
+	tx     tx.Transaction
+	writer *WriterReconnector
+}
+
+func NewWriterWithTransaction(
+	cred credentials.Credentials,
+	transaction tx.Transaction,
+	options []PublicWriterOption,
+) (*WriterWithTransaction, error) {
+	options = append(
+		options,
+		WithCredentials(cred),
+		WithTransaction(transaction),
+	)
+	cfg := newWriterReconnectorConfig(options...)
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.diskSpoolDir != "" {
+		return nil, xerrors.WithStackTrace(errDiskSpoolNotSupported)
+	}
+
+	res := &WriterWithTransaction{
+		tx:     transaction,
+		writer: newWriterReconnector(cfg),
+	}
+
+	transaction.OnBeforeCommit(res.onBeforeCommit)
+	transaction.OnCompleted(res.onCompleted)
+
+	return res, nil
+}
+
+// Write begins the transaction if it is not begun yet and writes messages inside the transaction
+func (w *WriterWithTransaction) Write(ctx context.Context, messages ...PublicMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := w.tx.UnLazy(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return w.writer.Write(ctx, messages)
+}
+
+func (w *WriterWithTransaction) WaitInit(ctx context.Context) (info InitialInfo, err error) {
+	return w.writer.WaitInit(ctx)
+}
+
+func (w *WriterWithTransaction) onBeforeCommit(ctx context.Context) error {
+	// all messages must be acked by server before commit of the transaction
+	return w.writer.Flush(ctx)
+}
+
+func (w *WriterWithTransaction) onCompleted(err error) {
+	if err == nil {
+		// all messages were flushed before commit
+		_ = w.writer.Close(context.Background())
+
+		return
+	}
+
+	// messages of rollbacked transaction must not be flushed
+	w.writer.queue.StopAddNewMessages(xerrors.WithStackTrace(errTransactionCompleted))
+	_ = w.writer.close(context.Background(), xerrors.WithStackTrace(fmt.Errorf("%w: %w", errTransactionCompleted, err)))
+}
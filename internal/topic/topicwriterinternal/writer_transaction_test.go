@@ -0,0 +1,92 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testTransaction struct {
+	id             string
+	onBeforeCommit []tx.OnBeforeCommitFunc
+	onCompleted    []tx.OnCompletedFunc
+}
+
+func (t *testTransaction) ID() string {
+	return t.id
+}
+
+func (t *testTransaction) SessionID() string {
+	return "session"
+}
+
+func (t *testTransaction) UnLazy(context.Context) error {
+	if t.id == "" {
+		t.id = "tx"
+	}
+
+	return nil
+}
+
+func (t *testTransaction) OnBeforeCommit(f tx.OnBeforeCommitFunc) {
+	t.onBeforeCommit = append(t.onBeforeCommit, f)
+}
+
+func (t *testTransaction) OnCompleted(f tx.OnCompletedFunc) {
+	t.onCompleted = append(t.onCompleted, f)
+}
+
+func TestSendMessagesToStreamWithTransaction(t *testing.T) {
+	mc := gomock.NewController(t)
+	stream := NewMockRawTopicWriterStream(mc)
+	stream.EXPECT().Send(&rawtopicwriter.WriteRequest{
+		Messages: []rawtopicwriter.MessageData{{SeqNo: 1}},
+		Codec:    rawtopiccommon.CodecRaw,
+		Tx: &rawtopiccommon.TransactionIdentity{
+			ID:      "tx",
+			Session: "session",
+		},
+	}).Return(nil)
+
+	cfg := WritersCommonConfig{tx: &testTransaction{id: "tx"}}
+	err := sendMessagesToStream(stream, rawtopiccommon.CodecRaw, newTestMessagesWithContent(1), cfg.txIdentity())
+	require.NoError(t, err)
+}
+
+func TestWriterWithTransaction(t *testing.T) {
+	t.Run("RegisterHooks", func(t *testing.T) {
+		transaction := &testTransaction{}
+		_, err := NewWriterWithTransaction(nil, transaction, []PublicWriterOption{
+			WithTopic("test-topic"),
+			WithConnectFunc(func(ctx context.Context) (RawTopicWriterStream, error) {
+				return nil, errors.New("test error")
+			}),
+		})
+		require.NoError(t, err)
+		require.Len(t, transaction.onBeforeCommit, 1)
+		require.Len(t, transaction.onCompleted, 1)
+		transaction.onCompleted[0](nil)
+	})
+	t.Run("StopOnRollback", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		transaction := &testTransaction{}
+		w := &WriterWithTransaction{
+			tx:     transaction,
+			writer: newTestWriterStopped(),
+		}
+		rollbackErr := errors.New("rollback")
+		w.onCompleted(rollbackErr)
+
+		err := w.Write(ctx, newTestMessages(1)...)
+		require.ErrorIs(t, err, errTransactionCompleted)
+		require.Equal(t, "tx", transaction.ID())
+	})
+}
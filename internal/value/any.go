@@ -0,0 +1,97 @@
+package value
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// Any returns go-native representation of primitive YDB value.
+// Null values represents as nil. Non-primitive values (containers, decimals,
+// variants, etc.) returns as is.
+func Any(v Value) (interface{}, error) {
+	switch vv := v.(type) {
+	case nil:
+		return nil, nil
+	case *optionalValue:
+		if vv.value == nil {
+			return nil, nil
+		}
+
+		return Any(vv.value)
+	case boolValue:
+		return bool(vv), nil
+	case int8Value:
+		return int8(vv), nil
+	case uint8Value:
+		return uint8(vv), nil
+	case int16Value:
+		return int16(vv), nil
+	case uint16Value:
+		return uint16(vv), nil
+	case int32Value:
+		return int32(vv), nil
+	case uint32Value:
+		return uint32(vv), nil
+	case int64Value:
+		return int64(vv), nil
+	case uint64Value:
+		return uint64(vv), nil
+	case *floatValue:
+		return vv.value, nil
+	case *doubleValue:
+		return vv.value, nil
+	case bytesValue:
+		return []byte(vv), nil
+	case textValue:
+		return string(vv), nil
+	case pgValue:
+		x, err := pg.Native(vv.pg())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return x, nil
+	case dyNumberValue:
+		return string(vv), nil
+	case *uuidValue:
+		return vv.value, nil
+	case dateValue:
+		return DateToTime(uint32(vv)), nil
+	case datetimeValue:
+		return DatetimeToTime(uint32(vv)), nil
+	case timestampValue:
+		return TimestampToTime(uint64(vv)), nil
+	case intervalValue:
+		return IntervalToDuration(int64(vv)), nil
+	case tzDateValue:
+		t, err := TzDateToTime(string(vv))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return t, nil
+	case tzDatetimeValue:
+		t, err := TzDatetimeToTime(string(vv))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return t, nil
+	case tzTimestampValue:
+		t, err := TzTimestampToTime(string(vv))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return t, nil
+	case jsonValue:
+		return xstring.ToBytes(string(vv)), nil
+	case jsonDocumentValue:
+		return xstring.ToBytes(string(vv)), nil
+	case ysonValue:
+		return []byte(vv), nil
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,92 @@
+package value
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestAny(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    Value
+		exp  interface{}
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			v:    BoolValue(true),
+			exp:  true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    Int32Value(42),
+			exp:  int32(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    Uint64Value(42),
+			exp:  uint64(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    DoubleValue(42.5),
+			exp:  42.5,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    TextValue("test"),
+			exp:  "test",
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    BytesValue([]byte("test")),
+			exp:  []byte("test"),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    JSONValue(`{"a":1}`),
+			exp:  []byte(`{"a":1}`),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    IntervalValue(1000),
+			exp:  time.Millisecond,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    TimestampValue(1000000),
+			exp:  time.Unix(1, 0),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    OptionalValue(Int64Value(42)),
+			exp:  int64(42),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    NullValue(types.Text),
+			exp:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			v:    ListValue(Int32Value(1)),
+			exp:  ListValue(Int32Value(1)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Any(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, v)
+		})
+	}
+}
+
+func TestCastToValue(t *testing.T) {
+	var dst Value
+	require.NoError(t, CastTo(Int32Value(42), &dst))
+	require.Equal(t, Int32Value(42), dst)
+}
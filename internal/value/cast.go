@@ -1,5 +1,11 @@
 package value
 
 func CastTo(v Value, dst interface{}) error {
+	if ptr, has := dst.(*Value); has {
+		*ptr = v
+
+		return nil
+	}
+
 	return v.castTo(dst)
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
@@ -304,12 +305,11 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 		), nil
 
 	case *types.PgType:
-		return &pgValue{
-			t: types.PgType{
-				OID: ttt.OID,
-			},
-			val: v.GetTextValue(),
-		}, nil
+		if _, isNull := v.GetValue().(*Ydb.Value_NullFlagValue); isNull {
+			return PgNullValue(ttt.OID), nil
+		}
+
+		return PgValue(ttt.OID, v.GetTextValue()), nil
 
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("uncovered type: %T", ttt))
@@ -1260,15 +1260,28 @@ func ListValue(items ...Value) *listValue {
 }
 
 type pgValue struct {
-	t   types.PgType
-	val string
+	t    types.PgType
+	val  string
+	null bool
 }
 
 func (v pgValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w  PgType to '%T' destination",
-		ErrCannotCast, dst,
-	))
+	if err := pg.Decode(v.pg(), dst); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w PgType(%v) to '%T' destination: %w",
+			ErrCannotCast, v.t.OID, dst, err,
+		))
+	}
+
+	return nil
+}
+
+func (v pgValue) pg() pg.Value {
+	return pg.Value{
+		OID:  v.t.OID,
+		Text: v.val,
+		Null: v.null,
+	}
 }
 
 func (v pgValue) Type() types.Type {
@@ -1276,6 +1289,12 @@ func (v pgValue) Type() types.Type {
 }
 
 func (v pgValue) toYDB(_ *allocator.Allocator) *Ydb.Value {
+	if v.null {
+		return &Ydb.Value{
+			Value: &Ydb.Value_NullFlagValue{},
+		}
+	}
+
 	//nolint:godox
 	// TODO: make allocator
 	return &Ydb.Value{
@@ -1286,6 +1305,10 @@ func (v pgValue) toYDB(_ *allocator.Allocator) *Ydb.Value {
 }
 
 func (v pgValue) Yql() string {
+	if v.null {
+		return fmt.Sprintf(`PgCast(NULL, PgType(%v))`, v.t.OID)
+	}
+
 	//nolint:godox
 	// TODO: call special function for unknown oids
 	// https://github.com/ydb-platform/ydb/issues/2706
@@ -1347,6 +1370,30 @@ func PgValue(oid uint32, val string) pgValue {
 	}
 }
 
+// PgNullValue makes NULL value of postgres type
+func PgNullValue(oid uint32) pgValue {
+	return pgValue{
+		t: types.PgType{
+			OID: oid,
+		},
+		null: true,
+	}
+}
+
+// PgValueFromGo makes postgres value from go value (int32 as int4, string as text, time.Time as timestamptz, etc.)
+func PgValueFromGo(v interface{}) (pgValue, error) {
+	vv, err := pg.Encode(v)
+	if err != nil {
+		return pgValue{}, xerrors.WithStackTrace(err)
+	}
+
+	if vv.Null {
+		return PgNullValue(vv.OID), nil
+	}
+
+	return PgValue(vv.OID, vv.Text), nil
+}
+
 func SetValue(items ...Value) *setValue {
 	sort.Slice(items, func(i, j int) bool {
 		return items[i].Yql() < items[j].Yql()
@@ -8,7 +8,6 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
@@ -80,9 +79,16 @@ func (e *operationOption) applyToOperationError(oe *operationError) {
 	oe.issues = e.issues
 }
 
+// operationStatus describes status of operation.
+// It is a copy of operation.Status for avoid import cycle between xerrors and operation packages
+type operationStatus interface {
+	GetStatus() Ydb.StatusIds_StatusCode
+	GetIssues() []*Ydb_Issue.IssueMessage
+}
+
 // FromOperation is an option for construct operation error from operation.Status
 // FromOperation must use as `Operation(FromOperation(operation.Status))`
-func FromOperation(operation operation.Status) *operationOption {
+func FromOperation(operation operationStatus) *operationOption {
 	return &operationOption{
 		code:   operation.GetStatus(),
 		issues: operation.GetIssues(),
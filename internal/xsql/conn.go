@@ -6,19 +6,14 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"io"
-	"path"
-	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/helpers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
-	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
-	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
@@ -68,6 +63,8 @@ func withTrace(t *trace.DatabaseSQL) connOption {
 type beginTxFunc func(ctx context.Context, txOptions driver.TxOptions) (currentTx, error)
 
 type conn struct {
+	metadata
+
 	ctx context.Context //nolint:containedctx
 
 	connector *Connector
@@ -88,10 +85,6 @@ type conn struct {
 	currentTx currentTx
 }
 
-func (c *conn) GetDatabaseName() string {
-	return c.connector.parent.Name()
-}
-
 func (c *conn) CheckNamedValue(*driver.NamedValue) error {
 	// on this stage allows all values
 	return nil
@@ -141,6 +134,13 @@ func newConn(ctx context.Context, c *Connector, s table.ClosableSession, opts ..
 			opt(cc)
 		}
 	}
+	cc.metadata = metadata{
+		connector: c,
+		trace:     cc.trace,
+		describeTable: func(ctx context.Context, tableName string) (options.Description, error) {
+			return s.DescribeTable(ctx, tableName)
+		},
+	}
 	c.attach(cc)
 
 	return cc
@@ -175,6 +175,14 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (_ driver.Stmt,
 	}, nil
 }
 
+func (c *conn) queryMode(ctx context.Context) QueryMode {
+	return queryModeFromContext(ctx, c.defaultQueryMode)
+}
+
+func (c *conn) closeSession(ctx context.Context) error {
+	return c.session.Close(ctx)
+}
+
 func (c *conn) sinceLastUsage() time.Duration {
 	return time.Since(time.Unix(c.lastUsage.Load(), 0))
 }
@@ -503,374 +511,3 @@ func (c *conn) BeginTx(ctx context.Context, txOptions driver.TxOptions) (_ drive
 
 	return tx, nil
 }
-
-func (c *conn) Version(_ context.Context) (_ string, _ error) {
-	const version = "default"
-
-	return version, nil
-}
-
-func (c *conn) IsTableExists(ctx context.Context, tableName string) (tableExists bool, finalErr error) {
-	tableName = c.normalizePath(tableName)
-	onDone := trace.DatabaseSQLOnConnIsTableExists(c.trace, &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*conn).IsTableExists"),
-		tableName,
-	)
-	defer func() {
-		onDone(tableExists, finalErr)
-	}()
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return false, xerrors.WithStackTrace(err)
-	}
-
-	return tableExists, nil
-}
-
-func (c *conn) IsColumnExists(ctx context.Context, tableName, columnName string) (columnExists bool, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return false, xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return false, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
-		if err != nil {
-			return err
-		}
-		for i := range desc.Columns {
-			if desc.Columns[i].Name == columnName {
-				columnExists = true
-
-				break
-			}
-		}
-
-		return nil
-	})
-	if err != nil {
-		return false, xerrors.WithStackTrace(err)
-	}
-
-	return columnExists, nil
-}
-
-func (c *conn) GetColumns(ctx context.Context, tableName string) (columns []string, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
-		if err != nil {
-			return err
-		}
-		for i := range desc.Columns {
-			columns = append(columns, desc.Columns[i].Name)
-		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	return columns, nil
-}
-
-func (c *conn) GetColumnType(ctx context.Context, tableName, columnName string) (dataType string, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return "", xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return "", xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	columnExist, err := c.IsColumnExists(ctx, tableName, columnName)
-	if err != nil {
-		return "", xerrors.WithStackTrace(err)
-	}
-	if !columnExist {
-		return "", xerrors.WithStackTrace(fmt.Errorf("column '%s' not exist in table '%s'", columnName, tableName))
-	}
-
-	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
-		if err != nil {
-			return err
-		}
-		for i := range desc.Columns {
-			if desc.Columns[i].Name == columnName {
-				dataType = desc.Columns[i].Type.Yql()
-
-				break
-			}
-		}
-
-		return nil
-	})
-	if err != nil {
-		return "", xerrors.WithStackTrace(err)
-	}
-
-	return dataType, nil
-}
-
-func (c *conn) GetPrimaryKeys(ctx context.Context, tableName string) (pkCols []string, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
-		if err != nil {
-			return err
-		}
-		pkCols = append(pkCols, desc.PrimaryKey...)
-
-		return nil
-	})
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	return pkCols, nil
-}
-
-func (c *conn) IsPrimaryKey(ctx context.Context, tableName, columnName string) (ok bool, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return false, xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return false, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	columnExist, err := c.IsColumnExists(ctx, tableName, columnName)
-	if err != nil {
-		return false, xerrors.WithStackTrace(err)
-	}
-	if !columnExist {
-		return false, xerrors.WithStackTrace(fmt.Errorf("column '%s' not exist in table '%s'", columnName, tableName))
-	}
-
-	pkCols, err := c.GetPrimaryKeys(ctx, tableName)
-	if err != nil {
-		return false, xerrors.WithStackTrace(err)
-	}
-	for _, pkCol := range pkCols {
-		if pkCol == columnName {
-			ok = true
-
-			break
-		}
-	}
-
-	return ok, nil
-}
-
-func (c *conn) normalizePath(folderOrTable string) (absPath string) {
-	return c.connector.pathNormalizer.NormalizePath(folderOrTable)
-}
-
-func isSysDir(databaseName, dirAbsPath string) bool {
-	for _, sysDir := range [...]string{
-		path.Join(databaseName, ".sys"),
-		path.Join(databaseName, ".sys_health"),
-	} {
-		if strings.HasPrefix(dirAbsPath, sysDir) {
-			return true
-		}
-	}
-
-	return false
-}
-
-func (c *conn) getTables(ctx context.Context, absPath string, recursive, excludeSysDirs bool) (
-	tables []string, _ error,
-) {
-	if excludeSysDirs && isSysDir(c.connector.parent.Name(), absPath) {
-		return nil, nil
-	}
-
-	var d scheme.Directory
-	err := c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		d, err = c.connector.parent.Scheme().ListDirectory(ctx, absPath)
-
-		return err
-	})
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	if !d.IsDirectory() && !d.IsDatabase() {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("'%s' is not a folder", absPath))
-	}
-
-	for i := range d.Children {
-		switch d.Children[i].Type {
-		case scheme.EntryTable, scheme.EntryColumnTable:
-			tables = append(tables, path.Join(absPath, d.Children[i].Name))
-		case scheme.EntryDirectory, scheme.EntryDatabase:
-			if recursive {
-				childTables, err := c.getTables(ctx, path.Join(absPath, d.Children[i].Name), recursive, excludeSysDirs)
-				if err != nil {
-					return nil, xerrors.WithStackTrace(err)
-				}
-				tables = append(tables, childTables...)
-			}
-		}
-	}
-
-	return tables, nil
-}
-
-func (c *conn) GetTables(ctx context.Context, folder string, recursive, excludeSysDirs bool) (
-	tables []string, _ error,
-) {
-	absPath := c.normalizePath(folder)
-
-	var e scheme.Entry
-	err := c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		e, err = c.connector.parent.Scheme().DescribePath(ctx, absPath)
-
-		return err
-	})
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	switch e.Type {
-	case scheme.EntryTable, scheme.EntryColumnTable:
-		return []string{e.Name}, err
-	case scheme.EntryDirectory, scheme.EntryDatabase:
-		tables, err = c.getTables(ctx, absPath, recursive, excludeSysDirs)
-		if err != nil {
-			return nil, xerrors.WithStackTrace(err)
-		}
-
-		for i := range tables {
-			tables[i] = strings.TrimPrefix(tables[i], absPath+"/")
-		}
-
-		return tables, nil
-	default:
-		return nil, xerrors.WithStackTrace(
-			fmt.Errorf("'%s' is not a table or directory (%s)", folder, e.Type.String()),
-		)
-	}
-}
-
-func (c *conn) GetIndexes(ctx context.Context, tableName string) (indexes []string, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
-		if err != nil {
-			return err
-		}
-		for i := range desc.Indexes {
-			indexes = append(indexes, desc.Indexes[i].Name)
-		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	return indexes, nil
-}
-
-func (c *conn) retryIdempotent(ctx context.Context, f func(ctx context.Context) error) error {
-	err := retry.Retry(ctx, f,
-		retry.WithIdempotent(true),
-		retry.WithTrace(c.connector.traceRetry),
-		retry.WithBudget(c.connector.retryBudget),
-	)
-	if err != nil {
-		return xerrors.WithStackTrace(err)
-	}
-
-	return nil
-}
-
-func (c *conn) GetIndexColumns(ctx context.Context, tableName, indexName string) (columns []string, _ error) {
-	tableName = c.normalizePath(tableName)
-	tableExists, err := helpers.IsEntryExists(ctx,
-		c.connector.parent.Scheme(), tableName,
-		scheme.EntryTable, scheme.EntryColumnTable,
-	)
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-	if !tableExists {
-		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
-	}
-
-	err = c.retryIdempotent(ctx, func(ctx context.Context) (err error) {
-		desc, err := c.session.DescribeTable(ctx, tableName)
-		if err != nil {
-			return err
-		}
-		for i := range desc.Indexes {
-			if desc.Indexes[i].Name == indexName {
-				columns = append(columns, desc.Indexes[i].IndexColumns...)
-
-				return nil
-			}
-		}
-
-		return xerrors.WithStackTrace(fmt.Errorf("index '%s' not found in table '%s'", indexName, tableName))
-	})
-	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
-	}
-
-	return columns, nil
-}
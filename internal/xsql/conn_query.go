@@ -0,0 +1,407 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// queryConn is a database/sql connection over query service session
+type queryConn struct {
+	metadata
+
+	ctx context.Context //nolint:containedctx
+
+	connector *Connector
+	trace     *trace.DatabaseSQL
+	session   *internalQuery.Session // Immutable and r/o usage.
+
+	closed           atomic.Bool
+	lastUsage        atomic.Int64
+	defaultQueryMode QueryMode
+
+	currentTx currentTx
+}
+
+var (
+	_ driver.Conn               = &queryConn{}
+	_ driver.ConnPrepareContext = &queryConn{}
+	_ driver.ConnBeginTx        = &queryConn{}
+	_ driver.ExecerContext      = &queryConn{}
+	_ driver.QueryerContext     = &queryConn{}
+	_ driver.Pinger             = &queryConn{}
+	_ driver.Validator          = &queryConn{}
+	_ driver.NamedValueChecker  = &queryConn{}
+)
+
+func (c *Connector) connectQueryService(ctx context.Context) (_ driver.Conn, err error) {
+	var (
+		onDone = trace.DatabaseSQLOnConnectorConnect(
+			c.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*Connector).connectQueryService"),
+		)
+		session interface {
+			ID() string
+			NodeID() uint32
+			Status() string
+			LastUsage() time.Time
+		}
+	)
+	defer func() {
+		onDone(err, session)
+	}()
+
+	s, err := internalQuery.CreateSession(ctx, c.parent.Query())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	cc := newQueryConn(ctx, c, s)
+	session = cc
+
+	return cc, nil
+}
+
+func newQueryConn(ctx context.Context, c *Connector, s *internalQuery.Session) *queryConn {
+	cc := &queryConn{
+		ctx:              ctx,
+		connector:        c,
+		trace:            c.trace,
+		session:          s,
+		defaultQueryMode: c.defaultQueryMode,
+	}
+	cc.metadata = metadata{
+		connector: c,
+		trace:     c.trace,
+		describeTable: func(ctx context.Context, tableName string) (desc options.Description, _ error) {
+			err := c.parent.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+				desc, err = s.DescribeTable(ctx, tableName)
+
+				return err
+			}, table.WithIdempotent())
+			if err != nil {
+				return desc, xerrors.WithStackTrace(err)
+			}
+
+			return desc, nil
+		},
+	}
+	cc.lastUsage.Store(time.Now().Unix())
+	c.attach(cc)
+
+	return cc
+}
+
+func (c *queryConn) ID() string {
+	return c.session.ID()
+}
+
+func (c *queryConn) NodeID() uint32 {
+	return uint32(c.session.NodeID())
+}
+
+func (c *queryConn) Status() string {
+	return c.session.Status()
+}
+
+func (c *queryConn) LastUsage() time.Time {
+	return time.Unix(c.lastUsage.Load(), 0)
+}
+
+func (c *queryConn) CheckNamedValue(*driver.NamedValue) error {
+	// on this stage allows all values
+	return nil
+}
+
+func (c *queryConn) IsValid() bool {
+	return c.isReady()
+}
+
+func (c *queryConn) isReady() bool {
+	return c.session.IsAlive()
+}
+
+func (c *queryConn) queryMode(ctx context.Context) QueryMode {
+	return queryModeFromContext(ctx, c.defaultQueryMode)
+}
+
+// withKeepInCache returns ctx as is because query service caches compiled queries implicitly
+func (c *queryConn) withKeepInCache(ctx context.Context) context.Context {
+	return ctx
+}
+
+func (c *queryConn) closeSession(ctx context.Context) error {
+	return c.session.Close(ctx)
+}
+
+func (c *queryConn) sinceLastUsage() time.Duration {
+	return time.Since(time.Unix(c.lastUsage.Load(), 0))
+}
+
+// queryTxControl returns transaction control of query service request for given query mode
+func queryTxControl(m QueryMode) (*query.TransactionControl, error) {
+	switch m {
+	case DataQueryMode:
+		return query.DefaultTxControl(), nil
+	case ScanQueryMode:
+		return query.SnapshotReadOnlyTxControl(), nil
+	case SchemeQueryMode, ScriptingQueryMode:
+		return query.NoTx(), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("unsupported query mode '%s' for query service", m))
+	}
+}
+
+func (c *queryConn) execute(ctx context.Context, m QueryMode, q string, args []driver.NamedValue) (
+	query.Result, error,
+) {
+	txControl, err := queryTxControl(m)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	normalizedQuery, parameters, err := c.normalize(q, args...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	_, res, err := c.session.Execute(ctx, normalizedQuery,
+		query.WithParameters(&parameters),
+		query.WithTxControl(txControl),
+		query.WithSyntax(c.connector.querySyntax),
+	)
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return res, nil
+}
+
+// readAll reads result sets of result to the end and returns final result error
+func readAll(ctx context.Context, res query.Result) error {
+	for {
+		_, err := res.NextResultSet(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				break
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return res.Err()
+}
+
+func (c *queryConn) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, finalErr error) {
+	if c.currentTx != nil {
+		return c.currentTx.PrepareContext(ctx, query)
+	}
+	onDone := trace.DatabaseSQLOnConnPrepare(c.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryConn).PrepareContext"),
+		query,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	if !c.isReady() {
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
+	}
+
+	return &stmt{
+		conn:      c,
+		processor: c,
+		ctx:       ctx,
+		query:     query,
+		trace:     c.trace,
+	}, nil
+}
+
+func (c *queryConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (
+	_ driver.Result, finalErr error,
+) {
+	if !c.isReady() {
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
+	}
+	if c.currentTx != nil {
+		return c.currentTx.ExecContext(ctx, query, args)
+	}
+
+	defer func() {
+		c.lastUsage.Store(time.Now().Unix())
+	}()
+
+	var (
+		m      = c.queryMode(ctx)
+		onDone = trace.DatabaseSQLOnConnExec(
+			c.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryConn).ExecContext"),
+			query, m.String(), xcontext.IsIdempotent(ctx), c.sinceLastUsage(),
+		)
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	res, err := c.execute(ctx, m, query, args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = res.Close(ctx)
+	}()
+	if err = readAll(ctx, res); err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return resultNoRows{}, nil
+}
+
+func (c *queryConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (
+	_ driver.Rows, finalErr error,
+) {
+	if !c.isReady() {
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
+	}
+	if c.currentTx != nil {
+		return c.currentTx.QueryContext(ctx, query, args)
+	}
+
+	defer func() {
+		c.lastUsage.Store(time.Now().Unix())
+	}()
+
+	var (
+		m      = c.queryMode(ctx)
+		onDone = trace.DatabaseSQLOnConnQuery(
+			c.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryConn).QueryContext"),
+			query, m.String(), xcontext.IsIdempotent(ctx), c.sinceLastUsage(),
+		)
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	res, err := c.execute(ctx, m, query, args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &queryRows{
+		result: res,
+	}, nil
+}
+
+func (c *queryConn) Ping(ctx context.Context) (finalErr error) {
+	onDone := trace.DatabaseSQLOnConnPing(c.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryConn).Ping"),
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if !c.isReady() {
+		return badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
+	}
+
+	return nil
+}
+
+func (c *queryConn) Close() (finalErr error) {
+	if c.closed.CompareAndSwap(false, true) {
+		c.connector.detach(c)
+		var (
+			ctx    = c.ctx
+			onDone = trace.DatabaseSQLOnConnClose(
+				c.trace, &ctx,
+				stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryConn).Close"),
+			)
+		)
+		defer func() {
+			onDone(finalErr)
+		}()
+		if c.currentTx != nil {
+			_ = c.currentTx.Rollback()
+		}
+		err := c.session.Close(xcontext.ValueOnly(ctx))
+		if err != nil {
+			return badconn.Map(xerrors.WithStackTrace(err))
+		}
+
+		return nil
+	}
+
+	return badconn.Map(xerrors.WithStackTrace(errConnClosedEarly))
+}
+
+func (c *queryConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errDeprecated
+}
+
+func (c *queryConn) Begin() (driver.Tx, error) {
+	return nil, errDeprecated
+}
+
+func (c *queryConn) normalize(q string, args ...driver.NamedValue) (query string, _ params.Parameters, _ error) {
+	return c.connector.Bindings.RewriteQuery(q, func() (ii []interface{}) {
+		for i := range args {
+			ii = append(ii, args[i])
+		}
+
+		return ii
+	}()...)
+}
+
+func (c *queryConn) BeginTx(ctx context.Context, txOptions driver.TxOptions) (_ driver.Tx, finalErr error) {
+	var tx currentTx
+	onDone := trace.DatabaseSQLOnConnBegin(c.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryConn).BeginTx"),
+	)
+	defer func() {
+		onDone(tx, finalErr)
+	}()
+
+	if c.currentTx != nil {
+		return nil, xerrors.WithStackTrace(
+			xerrors.Retryable(
+				&ConnAlreadyHaveTxError{
+					currentTx: c.currentTx.ID(),
+				},
+				xerrors.InvalidObject(),
+			),
+		)
+	}
+
+	if m := c.queryMode(ctx); m != DataQueryMode {
+		return nil, badconn.Map(
+			xerrors.WithStackTrace(
+				xerrors.Retryable(
+					fmt.Errorf("wrong query mode: %s", m.String()),
+					xerrors.InvalidObject(),
+					xerrors.WithName("WRONG_QUERY_MODE"),
+				),
+			),
+		)
+	}
+
+	var err error
+	tx, err = c.beginTx(ctx, txOptions)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return tx, nil
+}
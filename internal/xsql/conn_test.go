@@ -48,4 +48,16 @@ var (
 	_ interface {
 		GetIndexColumns(ctx context.Context, tableName string, indexName string) (columns []string, err error)
 	} = (*conn)(nil)
+
+	_ interface {
+		GetTables(ctx context.Context, folder string, recursive bool, excludeSysDirs bool) (tables []string, err error)
+	} = (*queryConn)(nil)
+
+	_ interface {
+		GetColumns(ctx context.Context, tableName string) (columns []string, err error)
+	} = (*queryConn)(nil)
+
+	_ interface {
+		IsTableExists(ctx context.Context, tableName string) (tableExists bool, err error)
+	} = (*queryConn)(nil)
 )
@@ -3,6 +3,7 @@ package xsql
 import (
 	"context"
 	"database/sql/driver"
+	"fmt"
 	"io"
 	"sync"
 	"time"
@@ -11,10 +12,12 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	queryOptions "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
@@ -190,6 +193,36 @@ func WithretryBudget(b budget.Budget) ConnectorOption {
 	return retryBudgetConnectorOption{b: b}
 }
 
+type queryServiceConnectorOption bool
+
+func (useQueryService queryServiceConnectorOption) Apply(c *Connector) error {
+	c.queryService = bool(useQueryService)
+
+	return nil
+}
+
+// WithQueryService switches database/sql connections from table service sessions to query service sessions
+func WithQueryService(useQueryService bool) ConnectorOption {
+	return queryServiceConnectorOption(useQueryService)
+}
+
+type pgArgsConnectorOption struct {
+	bind.PgArgs
+}
+
+func (o pgArgsConnectorOption) Apply(c *Connector) error {
+	c.Bindings = bind.Sort(append(c.Bindings, o.PgArgs))
+	c.querySyntax = queryOptions.SyntaxPostgreSQL
+
+	return nil
+}
+
+// WithPgArgs switches query service connections to PostgreSQL syntax of queries
+// with binding of args to $1, $2, ... placeholders
+func WithPgArgs() QueryBindConnectorOption {
+	return pgArgsConnectorOption{}
+}
+
 type fakeTxConnectorOption QueryMode
 
 func (m fakeTxConnectorOption) Apply(c *Connector) error {
@@ -206,6 +239,7 @@ func WithFakeTx(m QueryMode) ConnectorOption {
 type ydbDriver interface {
 	Name() string
 	Table() table.Client
+	Query() query.Client
 	Scripting() scripting.Client
 	Scheme() scheme.Client
 }
@@ -214,10 +248,11 @@ func Open(parent ydbDriver, opts ...ConnectorOption) (_ *Connector, err error) {
 	c := &Connector{
 		parent:           parent,
 		clock:            clockwork.NewRealClock(),
-		conns:            make(map[*conn]struct{}),
+		conns:            make(map[sqlConn]struct{}),
 		defaultTxControl: table.DefaultTxControl(),
 		defaultQueryMode: DefaultQueryMode,
 		pathNormalizer:   bind.TablePathPrefix(parent.Name()),
+		querySyntax:      queryOptions.SyntaxYQL,
 		trace:            &trace.DatabaseSQL{},
 	}
 	for _, opt := range opts {
@@ -227,6 +262,17 @@ func Open(parent ydbDriver, opts ...ConnectorOption) (_ *Connector, err error) {
 			}
 		}
 	}
+	if c.querySyntax == queryOptions.SyntaxPostgreSQL {
+		if !c.queryService {
+			return nil, xerrors.WithStackTrace(errPgArgsWithoutQueryService)
+		}
+		// table path prefix, declares and YQL args bindings rewrite query with YQL syntax
+		for _, b := range c.Bindings {
+			if _, isPgArgs := b.(bind.PgArgs); !isPgArgs {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errPgArgsWithOtherBindings, b))
+			}
+		}
+	}
 	if c.idleThreshold > 0 {
 		c.idleStopper = c.idleCloser()
 	}
@@ -234,6 +280,12 @@ func Open(parent ydbDriver, opts ...ConnectorOption) (_ *Connector, err error) {
 	return c, nil
 }
 
+// sqlConn is a database/sql connection which tracked by Connector
+type sqlConn interface {
+	sinceLastUsage() time.Duration
+	closeSession(ctx context.Context) error
+}
+
 type pathNormalizer interface {
 	NormalizePath(folderOrTable string) string
 }
@@ -251,7 +303,7 @@ type Connector struct {
 
 	onClose []func(connector *Connector)
 
-	conns    map[*conn]struct{}
+	conns    map[sqlConn]struct{}
 	connsMtx sync.RWMutex
 
 	idleStopper func()
@@ -262,6 +314,8 @@ type Connector struct {
 	defaultScanQueryOpts  []options.ExecuteScanQueryOption
 	disableServerBalancer bool
 	idleThreshold         time.Duration
+	queryService          bool
+	querySyntax           queryOptions.Syntax
 
 	trace       *trace.DatabaseSQL
 	traceRetry  *trace.Retry
@@ -287,14 +341,14 @@ func (c *Connector) idleCloser() (idleStopper func()) {
 			case <-idleThresholdTimer.Chan():
 				idleThresholdTimer.Stop() // no really need, stop for common style only
 				c.connsMtx.RLock()
-				conns := make([]*conn, 0, len(c.conns))
+				conns := make([]sqlConn, 0, len(c.conns))
 				for cc := range c.conns {
 					conns = append(conns, cc)
 				}
 				c.connsMtx.RUnlock()
 				for _, cc := range conns {
 					if cc.sinceLastUsage() > c.idleThreshold {
-						cc.session.Close(context.Background())
+						_ = cc.closeSession(context.Background())
 					}
 				}
 			}
@@ -317,19 +371,23 @@ func (c *Connector) Close() (err error) {
 	return nil
 }
 
-func (c *Connector) attach(cc *conn) {
+func (c *Connector) attach(cc sqlConn) {
 	c.connsMtx.Lock()
 	defer c.connsMtx.Unlock()
 	c.conns[cc] = struct{}{}
 }
 
-func (c *Connector) detach(cc *conn) {
+func (c *Connector) detach(cc sqlConn) {
 	c.connsMtx.Lock()
 	defer c.connsMtx.Unlock()
 	delete(c.conns, cc)
 }
 
 func (c *Connector) Connect(ctx context.Context) (_ driver.Conn, err error) {
+	if c.queryService {
+		return c.connectQueryService(ctx)
+	}
+
 	var (
 		onDone = trace.DatabaseSQLOnConnectorConnect(
 			c.trace, &ctx,
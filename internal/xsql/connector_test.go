@@ -0,0 +1,55 @@
+package xsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+)
+
+type testDriver struct {
+	ydbDriver
+}
+
+func (d testDriver) Name() string {
+	return "/local"
+}
+
+func TestOpenPgArgs(t *testing.T) {
+	t.Run("WithoutQueryService", func(t *testing.T) {
+		_, err := Open(testDriver{}, WithPgArgs())
+		require.ErrorIs(t, err, errPgArgsWithoutQueryService)
+	})
+	t.Run("QueryService", func(t *testing.T) {
+		c, err := Open(testDriver{}, WithQueryService(true), WithPgArgs())
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+	})
+	for _, tt := range []struct {
+		name string
+		opt  ConnectorOption
+	}{
+		{
+			name: "TablePathPrefix",
+			opt:  WithTablePathPrefix("/local/path"),
+		},
+		{
+			name: "AutoDeclare",
+			opt:  WithQueryBind(bind.AutoDeclare{}),
+		},
+		{
+			name: "PositionalArgs",
+			opt:  WithQueryBind(bind.PositionalArgs{}),
+		},
+		{
+			name: "NumericArgs",
+			opt:  WithQueryBind(bind.NumericArgs{}),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Open(testDriver{}, WithQueryService(true), WithPgArgs(), tt.opt)
+			require.ErrorIs(t, err, errPgArgsWithOtherBindings)
+		})
+	}
+}
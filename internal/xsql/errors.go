@@ -12,6 +12,9 @@ var (
 	errDeprecated      = driver.ErrSkip
 	errConnClosedEarly = xerrors.Retryable(errors.New("conn closed early"), xerrors.InvalidObject())
 	errNotReadyConn    = xerrors.Retryable(errors.New("conn not ready"), xerrors.InvalidObject())
+
+	errPgArgsWithoutQueryService = errors.New("PostgreSQL syntax args binding requires query service connections")
+	errPgArgsWithOtherBindings   = errors.New("PostgreSQL syntax args binding can't be combined with other bindings")
 )
 
 type ConnAlreadyHaveTxError struct {
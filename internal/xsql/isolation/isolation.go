@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
@@ -30,3 +31,24 @@ func ToYDB(opts driver.TxOptions) (txcControl table.TxOption, err error) {
 		"unsupported transaction options: %+v", opts,
 	))
 }
+
+// ToQueryTxSettings maps driver transaction options to query service transaction settings.
+// Query service supports interactive transactions with SerializableReadWrite and SnapshotReadOnly modes only.
+// It returns error on unsupported options.
+func ToQueryTxSettings(opts driver.TxOptions) (txSettings query.TransactionSettings, err error) {
+	level := sql.IsolationLevel(opts.Isolation)
+	switch level {
+	case sql.LevelDefault, sql.LevelSerializable:
+		if !opts.ReadOnly {
+			return query.TxSettings(query.WithSerializableReadWrite()), nil
+		}
+	case sql.LevelSnapshot:
+		if opts.ReadOnly {
+			return query.TxSettings(query.WithSnapshotReadOnly()), nil
+		}
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf(
+		"unsupported transaction options: %+v", opts,
+	))
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
@@ -164,3 +166,76 @@ func TestToYDB(t *testing.T) {
 		})
 	}
 }
+
+func TestToQueryTxSettings(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		txOptions  driver.TxOptions
+		txSettings query.TransactionSettings
+		err        bool
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelDefault),
+				ReadOnly:  false,
+			},
+			txSettings: query.TxSettings(query.WithSerializableReadWrite()),
+			err:        false,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSerializable),
+				ReadOnly:  false,
+			},
+			txSettings: query.TxSettings(query.WithSerializableReadWrite()),
+			err:        false,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSnapshot),
+				ReadOnly:  true,
+			},
+			txSettings: query.TxSettings(query.WithSnapshotReadOnly()),
+			err:        false,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSnapshot),
+				ReadOnly:  false,
+			},
+			err: true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelReadCommitted),
+				ReadOnly:  true,
+			},
+			err: true,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSerializable),
+				ReadOnly:  true,
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			txSettings, err := ToQueryTxSettings(tt.txOptions)
+			if !tt.err {
+				require.NoError(t, err)
+				a := allocator.New()
+				defer a.Free()
+				require.Equal(t, tt.txSettings.ToYDB(a).String(), txSettings.ToYDB(a).String())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,398 @@
+package xsql
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/helpers"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// metadata implements database schema introspection extensions of database/sql connection
+type metadata struct {
+	connector     *Connector
+	trace         *trace.DatabaseSQL
+	describeTable func(ctx context.Context, tableName string) (options.Description, error)
+}
+
+func (m *metadata) GetDatabaseName() string {
+	return m.connector.parent.Name()
+}
+
+func (m *metadata) Version(_ context.Context) (_ string, _ error) {
+	const version = "default"
+
+	return version, nil
+}
+
+func (m *metadata) IsTableExists(ctx context.Context, tableName string) (tableExists bool, finalErr error) {
+	tableName = m.normalizePath(tableName)
+	onDone := trace.DatabaseSQLOnConnIsTableExists(m.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*metadata).IsTableExists"),
+		tableName,
+	)
+	defer func() {
+		onDone(tableExists, finalErr)
+	}()
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+
+	return tableExists, nil
+}
+
+func (m *metadata) IsColumnExists(ctx context.Context, tableName, columnName string) (columnExists bool, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return false, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	err = m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		desc, err := m.describeTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		for i := range desc.Columns {
+			if desc.Columns[i].Name == columnName {
+				columnExists = true
+
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+
+	return columnExists, nil
+}
+
+func (m *metadata) GetColumns(ctx context.Context, tableName string) (columns []string, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	err = m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		desc, err := m.describeTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		for i := range desc.Columns {
+			columns = append(columns, desc.Columns[i].Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return columns, nil
+}
+
+func (m *metadata) GetColumnType(ctx context.Context, tableName, columnName string) (dataType string, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return "", xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	columnExist, err := m.IsColumnExists(ctx, tableName, columnName)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+	if !columnExist {
+		return "", xerrors.WithStackTrace(fmt.Errorf("column '%s' not exist in table '%s'", columnName, tableName))
+	}
+
+	err = m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		desc, err := m.describeTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		for i := range desc.Columns {
+			if desc.Columns[i].Name == columnName {
+				dataType = desc.Columns[i].Type.Yql()
+
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return dataType, nil
+}
+
+func (m *metadata) GetPrimaryKeys(ctx context.Context, tableName string) (pkCols []string, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	err = m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		desc, err := m.describeTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		pkCols = append(pkCols, desc.PrimaryKey...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return pkCols, nil
+}
+
+func (m *metadata) IsPrimaryKey(ctx context.Context, tableName, columnName string) (ok bool, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return false, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	columnExist, err := m.IsColumnExists(ctx, tableName, columnName)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+	if !columnExist {
+		return false, xerrors.WithStackTrace(fmt.Errorf("column '%s' not exist in table '%s'", columnName, tableName))
+	}
+
+	pkCols, err := m.GetPrimaryKeys(ctx, tableName)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+	for _, pkCol := range pkCols {
+		if pkCol == columnName {
+			ok = true
+
+			break
+		}
+	}
+
+	return ok, nil
+}
+
+func (m *metadata) normalizePath(folderOrTable string) (absPath string) {
+	return m.connector.pathNormalizer.NormalizePath(folderOrTable)
+}
+
+func isSysDir(databaseName, dirAbsPath string) bool {
+	for _, sysDir := range [...]string{
+		path.Join(databaseName, ".sys"),
+		path.Join(databaseName, ".sys_health"),
+	} {
+		if strings.HasPrefix(dirAbsPath, sysDir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *metadata) getTables(ctx context.Context, absPath string, recursive, excludeSysDirs bool) (
+	tables []string, _ error,
+) {
+	if excludeSysDirs && isSysDir(m.connector.parent.Name(), absPath) {
+		return nil, nil
+	}
+
+	var d scheme.Directory
+	err := m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		d, err = m.connector.parent.Scheme().ListDirectory(ctx, absPath)
+
+		return err
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if !d.IsDirectory() && !d.IsDatabase() {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("'%s' is not a folder", absPath))
+	}
+
+	for i := range d.Children {
+		switch d.Children[i].Type {
+		case scheme.EntryTable, scheme.EntryColumnTable:
+			tables = append(tables, path.Join(absPath, d.Children[i].Name))
+		case scheme.EntryDirectory, scheme.EntryDatabase:
+			if recursive {
+				childTables, err := m.getTables(ctx, path.Join(absPath, d.Children[i].Name), recursive, excludeSysDirs)
+				if err != nil {
+					return nil, xerrors.WithStackTrace(err)
+				}
+				tables = append(tables, childTables...)
+			}
+		}
+	}
+
+	return tables, nil
+}
+
+func (m *metadata) GetTables(ctx context.Context, folder string, recursive, excludeSysDirs bool) (
+	tables []string, _ error,
+) {
+	absPath := m.normalizePath(folder)
+
+	var e scheme.Entry
+	err := m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		e, err = m.connector.parent.Scheme().DescribePath(ctx, absPath)
+
+		return err
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	switch e.Type {
+	case scheme.EntryTable, scheme.EntryColumnTable:
+		return []string{e.Name}, err
+	case scheme.EntryDirectory, scheme.EntryDatabase:
+		tables, err = m.getTables(ctx, absPath, recursive, excludeSysDirs)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		for i := range tables {
+			tables[i] = strings.TrimPrefix(tables[i], absPath+"/")
+		}
+
+		return tables, nil
+	default:
+		return nil, xerrors.WithStackTrace(
+			fmt.Errorf("'%s' is not a table or directory (%s)", folder, e.Type.String()),
+		)
+	}
+}
+
+func (m *metadata) GetIndexes(ctx context.Context, tableName string) (indexes []string, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	err = m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		desc, err := m.describeTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		for i := range desc.Indexes {
+			indexes = append(indexes, desc.Indexes[i].Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return indexes, nil
+}
+
+func (m *metadata) retryIdempotent(ctx context.Context, f func(ctx context.Context) error) error {
+	err := retry.Retry(ctx, f,
+		retry.WithIdempotent(true),
+		retry.WithTrace(m.connector.traceRetry),
+		retry.WithBudget(m.connector.retryBudget),
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (m *metadata) GetIndexColumns(ctx context.Context, tableName, indexName string) (columns []string, _ error) {
+	tableName = m.normalizePath(tableName)
+	tableExists, err := helpers.IsEntryExists(ctx,
+		m.connector.parent.Scheme(), tableName,
+		scheme.EntryTable, scheme.EntryColumnTable,
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if !tableExists {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("table '%s' not exist", tableName))
+	}
+
+	err = m.retryIdempotent(ctx, func(ctx context.Context) (err error) {
+		desc, err := m.describeTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		for i := range desc.Indexes {
+			if desc.Indexes[i].Name == indexName {
+				columns = append(columns, desc.Indexes[i].IndexColumns...)
+
+				return nil
+			}
+		}
+
+		return xerrors.WithStackTrace(fmt.Errorf("index '%s' not found in table '%s'", indexName, tableName))
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return columns, nil
+}
@@ -0,0 +1,152 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+var (
+	_ driver.Rows                           = &queryRows{}
+	_ driver.RowsNextResultSet              = &queryRows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &queryRows{}
+	_ driver.RowsColumnTypeNullable         = &queryRows{}
+)
+
+// queryRows is a database/sql rows over query service result
+type queryRows struct {
+	result query.Result
+
+	// nextSet once need for get first result set as default.
+	// Iterate over many result sets must be with rows.NextResultSet()
+	nextSet sync.Once
+
+	resultSet   query.ResultSet
+	columns     []string
+	columnTypes []types.Type
+
+	// next result set prefetched by HasNextResultSet
+	nextResultSet    query.ResultSet
+	nextResultSetErr error
+}
+
+func (r *queryRows) firstResultSet() {
+	r.nextSet.Do(func() {
+		_ = r.moveToNextResultSet()
+	})
+}
+
+func (r *queryRows) setResultSet(rs query.ResultSet) {
+	r.resultSet = rs
+	r.columns, r.columnTypes = rs.Columns(), rs.ColumnTypes()
+}
+
+func (r *queryRows) Columns() []string {
+	r.firstResultSet()
+	cs := make([]string, 0, len(r.columns))
+	for _, name := range r.columns {
+		if !strings.HasPrefix(name, ignoreColumnPrefixName) {
+			cs = append(cs, name)
+		}
+	}
+
+	return cs
+}
+
+func (r *queryRows) ColumnTypeDatabaseTypeName(index int) string {
+	r.firstResultSet()
+
+	return r.columnTypes[index].Yql()
+}
+
+func (r *queryRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	r.firstResultSet()
+	_, nullable = r.columnTypes[index].(interface {
+		IsOptional()
+	})
+
+	return nullable, true
+}
+
+func (r *queryRows) HasNextResultSet() bool {
+	if r.nextResultSet == nil && r.nextResultSetErr == nil {
+		r.nextResultSet, r.nextResultSetErr = r.result.NextResultSet(context.Background())
+	}
+
+	return r.nextResultSetErr == nil
+}
+
+func (r *queryRows) NextResultSet() error {
+	r.nextSet.Do(func() {})
+
+	return r.moveToNextResultSet()
+}
+
+func (r *queryRows) moveToNextResultSet() error {
+	if !r.HasNextResultSet() {
+		err := r.nextResultSetErr
+		if xerrors.Is(err, io.EOF) {
+			return io.EOF
+		}
+
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	r.setResultSet(r.nextResultSet)
+	r.nextResultSet = nil
+
+	return nil
+}
+
+func (r *queryRows) Next(dst []driver.Value) error {
+	r.firstResultSet()
+	if r.resultSet == nil {
+		if r.nextResultSetErr != nil && !xerrors.Is(r.nextResultSetErr, io.EOF) {
+			return badconn.Map(xerrors.WithStackTrace(r.nextResultSetErr))
+		}
+
+		return io.EOF
+	}
+	row, err := r.resultSet.NextRow(context.Background())
+	if err != nil {
+		if xerrors.Is(err, io.EOF) {
+			return io.EOF
+		}
+
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	values := make([]value.Value, len(r.columns))
+	refs := make([]interface{}, len(values))
+	for i := range values {
+		refs[i] = &values[i]
+	}
+	if err = row.Scan(refs...); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	j := 0
+	for i := range values {
+		if strings.HasPrefix(r.columns[i], ignoreColumnPrefixName) {
+			continue
+		}
+		if j >= len(dst) {
+			break
+		}
+		if dst[j], err = value.Any(values[i]); err != nil {
+			return badconn.Map(xerrors.WithStackTrace(err))
+		}
+		j++
+	}
+
+	return nil
+}
+
+func (r *queryRows) Close() error {
+	return r.result.Close(context.Background())
+}
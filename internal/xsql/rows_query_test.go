@@ -0,0 +1,147 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
+)
+
+type testQueryRow []value.Value
+
+func (r testQueryRow) Scan(dst ...interface{}) error {
+	for i := range dst {
+		if err := value.CastTo(r[i], dst[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r testQueryRow) ScanNamed(...scanner.NamedDestination) error {
+	return nil
+}
+
+func (r testQueryRow) ScanStruct(interface{}, ...scanner.ScanStructOption) error {
+	return nil
+}
+
+type testQueryResultSet struct {
+	index       int
+	columns     []string
+	columnTypes []types.Type
+	rows        []testQueryRow
+}
+
+func (rs *testQueryResultSet) Index() int {
+	return rs.index
+}
+
+func (rs *testQueryResultSet) Columns() []string {
+	return rs.columns
+}
+
+func (rs *testQueryResultSet) ColumnTypes() []types.Type {
+	return rs.columnTypes
+}
+
+func (rs *testQueryResultSet) NextRow(context.Context) (query.Row, error) {
+	if len(rs.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := rs.rows[0]
+	rs.rows = rs.rows[1:]
+
+	return row, nil
+}
+
+type testQueryResult struct {
+	resultSets []*testQueryResultSet
+	closed     bool
+}
+
+func (r *testQueryResult) Close(context.Context) error {
+	r.closed = true
+
+	return nil
+}
+
+func (r *testQueryResult) NextResultSet(context.Context) (query.ResultSet, error) {
+	if len(r.resultSets) == 0 {
+		return nil, io.EOF
+	}
+	rs := r.resultSets[0]
+	r.resultSets = r.resultSets[1:]
+
+	return rs, nil
+}
+
+func (r *testQueryResult) Err() error {
+	return nil
+}
+
+func (r *testQueryResult) Stats() stats.QueryStats {
+	return nil
+}
+
+func TestQueryRows(t *testing.T) {
+	res := &testQueryResult{
+		resultSets: []*testQueryResultSet{
+			{
+				columns:     []string{"a", "b"},
+				columnTypes: []types.Type{types.Int32, types.NewOptional(types.Text)},
+				rows: []testQueryRow{
+					{value.Int32Value(1), value.OptionalValue(value.TextValue("1"))},
+					{value.Int32Value(2), value.NullValue(types.Text)},
+				},
+			},
+			{
+				index:       1,
+				columns:     []string{"c"},
+				columnTypes: []types.Type{types.Bool},
+				rows: []testQueryRow{
+					{value.BoolValue(true)},
+				},
+			},
+		},
+	}
+	rows := &queryRows{result: res}
+	require.Equal(t, []string{"a", "b"}, rows.Columns())
+	require.Equal(t, "Int32", rows.ColumnTypeDatabaseTypeName(0))
+	nullable, ok := rows.ColumnTypeNullable(1)
+	require.True(t, ok)
+	require.True(t, nullable)
+	dst := make([]driver.Value, 2)
+	require.NoError(t, rows.Next(dst))
+	require.Equal(t, []driver.Value{int32(1), "1"}, dst)
+	require.NoError(t, rows.Next(dst))
+	require.Equal(t, []driver.Value{int32(2), nil}, dst)
+	require.ErrorIs(t, rows.Next(dst), io.EOF)
+	require.True(t, rows.HasNextResultSet())
+	require.NoError(t, rows.NextResultSet())
+	require.Equal(t, []string{"c"}, rows.Columns())
+	dst = make([]driver.Value, 1)
+	require.NoError(t, rows.Next(dst))
+	require.Equal(t, []driver.Value{true}, dst)
+	require.ErrorIs(t, rows.Next(dst), io.EOF)
+	require.False(t, rows.HasNextResultSet())
+	require.ErrorIs(t, rows.NextResultSet(), io.EOF)
+	require.NoError(t, rows.Close())
+	require.True(t, res.closed)
+}
+
+func TestQueryRowsEmptyResult(t *testing.T) {
+	rows := &queryRows{result: &testQueryResult{}}
+	require.Empty(t, rows.Columns())
+	require.ErrorIs(t, rows.Next(nil), io.EOF)
+	require.False(t, rows.HasNextResultSet())
+}
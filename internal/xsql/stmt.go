@@ -11,8 +11,15 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+// stmtConn is a connection which prepares statements
+type stmtConn interface {
+	isReady() bool
+	queryMode(ctx context.Context) QueryMode
+	withKeepInCache(ctx context.Context) context.Context
+}
+
 type stmt struct {
-	conn      *conn
+	conn      stmtConn
 	processor interface {
 		driver.ExecerContext
 		driver.QueryerContext
@@ -40,7 +47,7 @@ func (stmt *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_
 	if !stmt.conn.isReady() {
 		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
-	switch m := queryModeFromContext(ctx, stmt.conn.defaultQueryMode); m {
+	switch m := stmt.conn.queryMode(ctx); m {
 	case DataQueryMode:
 		return stmt.processor.QueryContext(stmt.conn.withKeepInCache(ctx), stmt.query, args)
 	default:
@@ -59,7 +66,7 @@ func (stmt *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_
 	if !stmt.conn.isReady() {
 		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
-	switch m := queryModeFromContext(ctx, stmt.conn.defaultQueryMode); m {
+	switch m := stmt.conn.queryMode(ctx); m {
 	case DataQueryMode:
 		return stmt.processor.ExecContext(stmt.conn.withKeepInCache(ctx), stmt.query, args)
 	default:
@@ -0,0 +1,215 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/isolation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// queryTx is a database/sql transaction over query service interactive transaction
+type queryTx struct {
+	conn *queryConn
+	ctx  context.Context //nolint:containedctx
+	tx   query.Transaction
+}
+
+var (
+	_ driver.Tx                   = &queryTx{}
+	_ driver.ExecerContext        = &queryTx{}
+	_ driver.QueryerContext       = &queryTx{}
+	_ table.TransactionIdentifier = &queryTx{}
+)
+
+func (c *queryConn) beginTx(ctx context.Context, txOptions driver.TxOptions) (currentTx, error) {
+	if c.currentTx != nil {
+		return nil, badconn.Map(
+			xerrors.WithStackTrace(
+				fmt.Errorf("broken conn state: conn=%q already have current tx=%q",
+					c.ID(), c.currentTx.ID(),
+				),
+			),
+		)
+	}
+	txSettings, err := isolation.ToQueryTxSettings(txOptions)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	transaction, err := c.session.Begin(ctx, txSettings)
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+	c.currentTx = &queryTx{
+		conn: c,
+		ctx:  ctx,
+		tx:   transaction,
+	}
+
+	return c.currentTx, nil
+}
+
+func (tx *queryTx) ID() string {
+	return tx.tx.ID()
+}
+
+func (tx *queryTx) checkTxState() error {
+	if tx.conn.currentTx == tx {
+		return nil
+	}
+	if tx.conn.currentTx == nil {
+		return fmt.Errorf("broken conn state: tx=%q not related to conn=%q",
+			tx.ID(), tx.conn.ID(),
+		)
+	}
+
+	return fmt.Errorf("broken conn state: tx=%s not related to conn=%q (conn have current tx=%q)",
+		tx.conn.currentTx.ID(), tx.conn.ID(), tx.ID(),
+	)
+}
+
+func (tx *queryTx) Commit() (finalErr error) {
+	var (
+		ctx    = tx.ctx
+		onDone = trace.DatabaseSQLOnTxCommit(tx.conn.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryTx).Commit"),
+			tx,
+		)
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if err := tx.checkTxState(); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	defer func() {
+		tx.conn.currentTx = nil
+	}()
+	if err := tx.tx.CommitTx(tx.ctx); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return nil
+}
+
+func (tx *queryTx) Rollback() (finalErr error) {
+	var (
+		ctx    = tx.ctx
+		onDone = trace.DatabaseSQLOnTxRollback(tx.conn.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryTx).Rollback"),
+			tx,
+		)
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if err := tx.checkTxState(); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+	defer func() {
+		tx.conn.currentTx = nil
+	}()
+	if err := tx.tx.Rollback(tx.ctx); err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return nil
+}
+
+func (tx *queryTx) execute(ctx context.Context, q string, args []driver.NamedValue) (query.Result, error) {
+	if m := tx.conn.queryMode(ctx); m != DataQueryMode {
+		return nil, badconn.Map(
+			xerrors.WithStackTrace(
+				xerrors.Retryable(
+					fmt.Errorf("wrong query mode: %s", m.String()),
+					xerrors.InvalidObject(),
+					xerrors.WithName("WRONG_QUERY_MODE"),
+				),
+			),
+		)
+	}
+	normalizedQuery, parameters, err := tx.conn.normalize(q, args...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	res, err := tx.tx.Execute(ctx, normalizedQuery,
+		query.WithParameters(&parameters),
+		query.WithSyntax(tx.conn.connector.querySyntax),
+	)
+	if err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return res, nil
+}
+
+func (tx *queryTx) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (
+	_ driver.Rows, finalErr error,
+) {
+	onDone := trace.DatabaseSQLOnTxQuery(tx.conn.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryTx).QueryContext"),
+		tx.ctx, tx, query,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	res, err := tx.execute(ctx, query, args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &queryRows{
+		result: res,
+	}, nil
+}
+
+func (tx *queryTx) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (
+	_ driver.Result, finalErr error,
+) {
+	onDone := trace.DatabaseSQLOnTxExec(tx.conn.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryTx).ExecContext"),
+		tx.ctx, tx, query,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	res, err := tx.execute(ctx, query, args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = res.Close(ctx)
+	}()
+	if err = readAll(ctx, res); err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
+
+	return resultNoRows{}, nil
+}
+
+func (tx *queryTx) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, finalErr error) {
+	onDone := trace.DatabaseSQLOnTxPrepare(tx.conn.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*queryTx).PrepareContext"),
+		tx.ctx, tx, query,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+	if !tx.conn.isReady() {
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
+	}
+
+	return &stmt{
+		conn:      tx.conn,
+		processor: tx,
+		ctx:       ctx,
+		query:     query,
+		trace:     tx.conn.trace,
+	}, nil
+}
@@ -19,13 +19,18 @@ func Unwrap[T *sql.DB | *sql.Conn](v T) (connector *Connector, err error) {
 		return nil, xerrors.WithStackTrace(fmt.Errorf("%T is not a *driverWrapper", d))
 	case *sql.Conn:
 		if err = vv.Raw(func(driverConn interface{}) error {
-			if cc, ok := driverConn.(*conn); ok {
+			switch cc := driverConn.(type) {
+			case *conn:
 				connector = cc.connector
 
 				return nil
-			}
+			case *queryConn:
+				connector = cc.connector
 
-			return xerrors.WithStackTrace(fmt.Errorf("%T is not a *conn", driverConn))
+				return nil
+			default:
+				return xerrors.WithStackTrace(fmt.Errorf("%T is not a *conn", driverConn))
+			}
 		}); err != nil {
 			return nil, badconn.Map(xerrors.WithStackTrace(err))
 		}
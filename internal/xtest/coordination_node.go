@@ -0,0 +1,446 @@
+package xtest
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// CoordinationNode is an in-memory coordination node which serves the session streams of the coordination client.
+// It implements grpc.ClientConnInterface and supports sessions, semaphores creation, acquiring, releasing and
+// describing with watches only.
+type CoordinationNode struct {
+	m             sync.Mutex
+	lastSessionID uint64
+	lastOrderID   uint64
+	streams       map[uint64]*coordinationSessionStream
+	semaphores    map[string]*coordinationSemaphore
+}
+
+type coordinationSemaphore struct {
+	name      string
+	limit     uint64
+	ephemeral bool
+	data      []byte
+	owners    []*coordinationSemaphoreSession
+	waiters   []*coordinationSemaphoreSession
+	watches   map[uint64]*Ydb_Coordination.SessionRequest_DescribeSemaphore
+}
+
+type coordinationSemaphoreSession struct {
+	sessionID uint64
+	orderID   uint64
+	reqID     uint64
+	count     uint64
+	data      []byte
+}
+
+// NewCoordinationNode creates an empty coordination node.
+func NewCoordinationNode() *CoordinationNode {
+	return &CoordinationNode{
+		streams:    make(map[uint64]*coordinationSessionStream),
+		semaphores: make(map[string]*coordinationSemaphore),
+	}
+}
+
+// WaitersCount returns the count of sessions waiting for the semaphore.
+func (n *CoordinationNode) WaitersCount(name string) int {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	if sem, ok := n.semaphores[name]; ok {
+		return len(sem.waiters)
+	}
+
+	return 0
+}
+
+func (n *CoordinationNode) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	return grpcStatus.Error(grpcCodes.Unimplemented, method)
+}
+
+func (n *CoordinationNode) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return &coordinationSessionStream{
+		node:      n,
+		ctx:       ctx,
+		responses: make(chan *Ydb_Coordination.SessionResponse, 1024), //nolint:gomnd
+	}, nil
+}
+
+func (n *CoordinationNode) handle(stream *coordinationSessionStream, request *Ydb_Coordination.SessionRequest) {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	switch req := request.GetRequest().(type) {
+	case *Ydb_Coordination.SessionRequest_SessionStart_:
+		sessionID := req.SessionStart.GetSessionId()
+		if sessionID == 0 {
+			n.lastSessionID++
+			sessionID = n.lastSessionID
+		} else if _, ok := n.streams[sessionID]; !ok {
+			stream.send(&Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_Failure_{
+					Failure: &Ydb_Coordination.SessionResponse_Failure{Status: Ydb.StatusIds_SESSION_EXPIRED},
+				},
+			})
+
+			return
+		}
+		stream.sessionID = sessionID
+		n.streams[sessionID] = stream
+		stream.send(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_SessionStarted_{
+				SessionStarted: &Ydb_Coordination.SessionResponse_SessionStarted{
+					SessionId:     sessionID,
+					TimeoutMillis: req.SessionStart.GetTimeoutMillis(),
+				},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_SessionStop_:
+		n.dropSession(stream.sessionID)
+		stream.send(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_SessionStopped_{
+				SessionStopped: &Ydb_Coordination.SessionResponse_SessionStopped{SessionId: stream.sessionID},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_CreateSemaphore_:
+		status := Ydb.StatusIds_SUCCESS
+		if _, ok := n.semaphores[req.CreateSemaphore.GetName()]; ok {
+			status = Ydb.StatusIds_ALREADY_EXISTS
+		} else {
+			n.semaphores[req.CreateSemaphore.GetName()] = &coordinationSemaphore{
+				name:    req.CreateSemaphore.GetName(),
+				limit:   req.CreateSemaphore.GetLimit(),
+				data:    req.CreateSemaphore.GetData(),
+				watches: make(map[uint64]*Ydb_Coordination.SessionRequest_DescribeSemaphore),
+			}
+		}
+		stream.send(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_CreateSemaphoreResult_{
+				CreateSemaphoreResult: &Ydb_Coordination.SessionResponse_CreateSemaphoreResult{
+					ReqId:  req.CreateSemaphore.GetReqId(),
+					Status: status,
+				},
+			},
+		})
+	case *Ydb_Coordination.SessionRequest_AcquireSemaphore_:
+		n.acquire(stream, req.AcquireSemaphore)
+	case *Ydb_Coordination.SessionRequest_ReleaseSemaphore_:
+		n.release(stream, req.ReleaseSemaphore)
+	case *Ydb_Coordination.SessionRequest_DescribeSemaphore_:
+		n.describe(stream, req.DescribeSemaphore)
+	}
+}
+
+func (n *CoordinationNode) acquire(
+	stream *coordinationSessionStream,
+	req *Ydb_Coordination.SessionRequest_AcquireSemaphore,
+) {
+	result := func(status Ydb.StatusIds_StatusCode, acquired bool) *Ydb_Coordination.SessionResponse {
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+				AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+					ReqId:    req.GetReqId(),
+					Status:   status,
+					Acquired: acquired,
+				},
+			},
+		}
+	}
+
+	sem, ok := n.semaphores[req.GetName()]
+	if !ok && req.GetEphemeral() {
+		sem = &coordinationSemaphore{
+			name:      req.GetName(),
+			limit:     math.MaxUint64,
+			ephemeral: true,
+			watches:   make(map[uint64]*Ydb_Coordination.SessionRequest_DescribeSemaphore),
+		}
+		n.semaphores[req.GetName()] = sem
+	} else if !ok {
+		stream.send(result(Ydb.StatusIds_NOT_FOUND, false))
+
+		return
+	}
+	for _, owner := range sem.owners {
+		if owner.sessionID == stream.sessionID {
+			owner.data = req.GetData()
+			stream.send(result(Ydb.StatusIds_SUCCESS, true))
+			n.notify(sem)
+
+			return
+		}
+	}
+	for _, waiter := range sem.waiters {
+		if waiter.sessionID == stream.sessionID {
+			waiter.reqID = req.GetReqId()
+			waiter.data = req.GetData()
+			stream.send(&Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_AcquireSemaphorePending_{
+					AcquireSemaphorePending: &Ydb_Coordination.SessionResponse_AcquireSemaphorePending{
+						ReqId: req.GetReqId(),
+					},
+				},
+			})
+
+			return
+		}
+	}
+
+	n.lastOrderID++
+	sem.waiters = append(sem.waiters, &coordinationSemaphoreSession{
+		sessionID: stream.sessionID,
+		orderID:   n.lastOrderID,
+		reqID:     req.GetReqId(),
+		count:     req.GetCount(),
+		data:      req.GetData(),
+	})
+	n.promote(sem)
+	if len(sem.waiters) > 0 && sem.waiters[len(sem.waiters)-1].sessionID == stream.sessionID {
+		stream.send(&Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_AcquireSemaphorePending_{
+				AcquireSemaphorePending: &Ydb_Coordination.SessionResponse_AcquireSemaphorePending{
+					ReqId: req.GetReqId(),
+				},
+			},
+		})
+	}
+}
+
+func (n *CoordinationNode) release(
+	stream *coordinationSessionStream,
+	req *Ydb_Coordination.SessionRequest_ReleaseSemaphore,
+) {
+	released := false
+	if sem, ok := n.semaphores[req.GetName()]; ok {
+		released = n.removeSession(sem, stream.sessionID)
+		n.deleteUnused(sem)
+	}
+	stream.send(&Ydb_Coordination.SessionResponse{
+		Response: &Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult_{
+			ReleaseSemaphoreResult: &Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult{
+				ReqId:    req.GetReqId(),
+				Status:   Ydb.StatusIds_SUCCESS,
+				Released: released,
+			},
+		},
+	})
+}
+
+func (n *CoordinationNode) describe(
+	stream *coordinationSessionStream,
+	req *Ydb_Coordination.SessionRequest_DescribeSemaphore,
+) {
+	result := &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult{
+		ReqId:  req.GetReqId(),
+		Status: Ydb.StatusIds_NOT_FOUND,
+	}
+	if sem, ok := n.semaphores[req.GetName()]; ok {
+		result.Status = Ydb.StatusIds_SUCCESS
+		result.SemaphoreDescription = &Ydb_Coordination.SemaphoreDescription{
+			Name:      req.GetName(),
+			Limit:     sem.limit,
+			Ephemeral: sem.ephemeral,
+			Data:      sem.data,
+		}
+		for _, owner := range sem.owners {
+			result.SemaphoreDescription.Count += owner.count
+			if req.GetIncludeOwners() {
+				result.SemaphoreDescription.Owners = append(result.SemaphoreDescription.Owners, owner.toProto())
+			}
+		}
+		if req.GetIncludeWaiters() {
+			for _, waiter := range sem.waiters {
+				result.SemaphoreDescription.Waiters = append(result.SemaphoreDescription.Waiters, waiter.toProto())
+			}
+		}
+		if req.GetWatchData() || req.GetWatchOwners() {
+			sem.watches[stream.sessionID] = req
+			result.WatchAdded = true
+		}
+	}
+	stream.send(&Ydb_Coordination.SessionResponse{
+		Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_{
+			DescribeSemaphoreResult: result,
+		},
+	})
+}
+
+// promote moves the waiters which fit the limit to the owners and reports whether the owners were changed.
+func (n *CoordinationNode) promote(sem *coordinationSemaphore) bool {
+	promoted := false
+	for len(sem.waiters) > 0 {
+		var used uint64
+		for _, owner := range sem.owners {
+			used += owner.count
+		}
+		if sem.waiters[0].count > sem.limit-used {
+			break
+		}
+
+		waiter := sem.waiters[0]
+		sem.waiters = sem.waiters[1:]
+		sem.owners = append(sem.owners, waiter)
+		promoted = true
+
+		if stream, ok := n.streams[waiter.sessionID]; ok {
+			stream.send(&Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+					AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+						ReqId:    waiter.reqID,
+						Status:   Ydb.StatusIds_SUCCESS,
+						Acquired: true,
+					},
+				},
+			})
+		}
+	}
+	if promoted {
+		n.notify(sem)
+	}
+
+	return promoted
+}
+
+// removeSession removes the session from the owners and waiters of the semaphore and reports whether it was found.
+func (n *CoordinationNode) removeSession(sem *coordinationSemaphore, sessionID uint64) bool {
+	for i, owner := range sem.owners {
+		if owner.sessionID == sessionID {
+			sem.owners = append(sem.owners[:i], sem.owners[i+1:]...)
+			n.notify(sem)
+			n.promote(sem)
+
+			return true
+		}
+	}
+	for i, waiter := range sem.waiters {
+		if waiter.sessionID == sessionID {
+			sem.waiters = append(sem.waiters[:i], sem.waiters[i+1:]...)
+			if stream, ok := n.streams[sessionID]; ok {
+				stream.send(&Ydb_Coordination.SessionResponse{
+					Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+						AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+							ReqId:  waiter.reqID,
+							Status: Ydb.StatusIds_ABORTED,
+						},
+					},
+				})
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *CoordinationNode) notify(sem *coordinationSemaphore) {
+	for sessionID, watch := range sem.watches {
+		if !watch.GetWatchOwners() {
+			continue
+		}
+		delete(sem.watches, sessionID)
+		if stream, ok := n.streams[sessionID]; ok {
+			stream.send(&Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_{
+					DescribeSemaphoreChanged: &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{
+						ReqId:         watch.GetReqId(),
+						OwnersChanged: true,
+					},
+				},
+			})
+		}
+	}
+}
+
+func (n *CoordinationNode) dropSession(sessionID uint64) {
+	for _, sem := range n.semaphores {
+		delete(sem.watches, sessionID)
+		n.removeSession(sem, sessionID)
+		n.deleteUnused(sem)
+	}
+	delete(n.streams, sessionID)
+}
+
+// deleteUnused deletes the ephemeral semaphore if there are no owners and waiters left.
+func (n *CoordinationNode) deleteUnused(sem *coordinationSemaphore) {
+	if sem.ephemeral && len(sem.owners) == 0 && len(sem.waiters) == 0 {
+		delete(n.semaphores, sem.name)
+	}
+}
+
+func (s *coordinationSemaphoreSession) toProto() *Ydb_Coordination.SemaphoreSession {
+	return &Ydb_Coordination.SemaphoreSession{
+		SessionId: s.sessionID,
+		OrderId:   s.orderID,
+		Count:     s.count,
+		Data:      s.data,
+	}
+}
+
+// coordinationSessionStream is a client side of the coordination session stream served by the CoordinationNode.
+type coordinationSessionStream struct {
+	node      *CoordinationNode
+	ctx       context.Context //nolint:containedctx
+	sessionID uint64
+	responses chan *Ydb_Coordination.SessionResponse
+}
+
+func (s *coordinationSessionStream) send(response *Ydb_Coordination.SessionResponse) {
+	s.responses <- response
+}
+
+func (s *coordinationSessionStream) Header() (metadata.MD, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func (s *coordinationSessionStream) Trailer() metadata.MD {
+	return nil
+}
+
+func (s *coordinationSessionStream) CloseSend() error {
+	return nil
+}
+
+func (s *coordinationSessionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *coordinationSessionStream) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	s.node.handle(s, m.(*Ydb_Coordination.SessionRequest))
+
+	return nil
+}
+
+func (s *coordinationSessionStream) RecvMsg(m interface{}) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case response := <-s.responses:
+		proto.Merge(m.(proto.Message), response)
+
+		return nil
+	}
+}
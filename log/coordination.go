@@ -344,5 +344,73 @@ func internalCoordination(
 				)
 			}
 		},
+		OnElectionCampaign: func(
+			info trace.CoordinationElectionCampaignStartInfo,
+		) func(
+			info trace.CoordinationElectionCampaignDoneInfo,
+		) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "coordination", "election", "campaign")
+			l.Log(ctx, "start",
+				String("name", info.Name),
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+			start := time.Now()
+
+			return func(info trace.CoordinationElectionCampaignDoneInfo) {
+				if info.Error == nil {
+					l.Log(WithLevel(ctx, INFO), "elected",
+						latencyField(start),
+					)
+				} else {
+					l.Log(WithLevel(ctx, ERROR), "fail",
+						latencyField(start),
+						Error(info.Error),
+						versionField(),
+					)
+				}
+			}
+		},
+		OnElectionResign: func(
+			info trace.CoordinationElectionResignStartInfo,
+		) func(
+			info trace.CoordinationElectionResignDoneInfo,
+		) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return nil
+			}
+			ctx := with(context.Background(), TRACE, "ydb", "coordination", "election", "resign")
+			l.Log(ctx, "start",
+				String("name", info.Name),
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+			start := time.Now()
+
+			return func(info trace.CoordinationElectionResignDoneInfo) {
+				if info.Error == nil {
+					l.Log(WithLevel(ctx, INFO), "done",
+						latencyField(start),
+					)
+				} else {
+					l.Log(WithLevel(ctx, ERROR), "fail",
+						latencyField(start),
+						Error(info.Error),
+						versionField(),
+					)
+				}
+			}
+		},
+		OnElectionLeadershipLost: func(info trace.CoordinationElectionLeadershipLostInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), WARN, "ydb", "coordination", "election", "leadership", "lost")
+			l.Log(ctx, "",
+				String("name", info.Name),
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+		},
 	}
 }
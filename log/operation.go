@@ -0,0 +1,148 @@
+package log
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Operation returns trace.Operation with logging events from details
+func Operation(l Logger, d trace.Detailer, opts ...Option) (t trace.Operation) {
+	return internalOperation(wrapLogger(l, opts...), d)
+}
+
+func internalOperation(l *wrapper, d trace.Detailer) (t trace.Operation) {
+	t.OnGet = func(info trace.OperationGetStartInfo) func(trace.OperationGetDoneInfo) {
+		if d.Details()&trace.OperationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "operation", "get")
+		l.Log(ctx, "start",
+			String("id", info.OperationID),
+		)
+		start := time.Now()
+
+		return func(info trace.OperationGetDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					Bool("ready", info.Ready),
+					String("status", info.Status),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					Error(info.Error),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnList = func(info trace.OperationListStartInfo) func(trace.OperationListDoneInfo) {
+		if d.Details()&trace.OperationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "operation", "list")
+		l.Log(ctx, "start",
+			String("kind", info.Kind),
+			String("pageSize", strconv.FormatUint(info.PageSize, 10)),
+			String("pageToken", info.PageToken),
+		)
+		start := time.Now()
+
+		return func(info trace.OperationListDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					Int("count", info.Count),
+					String("nextToken", info.NextToken),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					Error(info.Error),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnCancel = func(info trace.OperationCancelStartInfo) func(trace.OperationCancelDoneInfo) {
+		if d.Details()&trace.OperationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "operation", "cancel")
+		l.Log(ctx, "start",
+			String("id", info.OperationID),
+		)
+		start := time.Now()
+
+		return func(info trace.OperationCancelDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "done",
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					Error(info.Error),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnForget = func(info trace.OperationForgetStartInfo) func(trace.OperationForgetDoneInfo) {
+		if d.Details()&trace.OperationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "operation", "forget")
+		l.Log(ctx, "start",
+			String("id", info.OperationID),
+		)
+		start := time.Now()
+
+		return func(info trace.OperationForgetDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "done",
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					Error(info.Error),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnWait = func(info trace.OperationWaitStartInfo) func(trace.OperationWaitDoneInfo) {
+		if d.Details()&trace.OperationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, DEBUG, "ydb", "operation", "wait")
+		l.Log(ctx, "start",
+			String("id", info.OperationID),
+		)
+		start := time.Now()
+
+		return func(info trace.OperationWaitDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "done",
+					latencyField(start),
+					Int("attempts", info.Attempts),
+					String("status", info.Status),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					Error(info.Error),
+					latencyField(start),
+					Int("attempts", info.Attempts),
+					String("status", info.Status),
+					versionField(),
+				)
+			}
+		}
+	}
+
+	return t
+}
@@ -416,6 +416,8 @@ func internalQuery(
 				String("SessionID", info.Session.ID()),
 				String("TransactionID", info.Tx.ID()),
 				String("SessionStatus", info.Session.Status()),
+				Bool("BeginInlined", info.BeginInlined),
+				Bool("CommitInlined", info.CommitInlined),
 			)
 			start := time.Now()
 
@@ -473,9 +475,16 @@ func internalQuery(
 
 			return func(info trace.QueryResultNextPartDoneInfo) {
 				if info.Error == nil {
-					l.Log(ctx, "done",
+					fields := []Field{
 						latencyField(start),
-					)
+					}
+					if info.Stats != nil {
+						fields = append(fields,
+							Duration("total_duration", info.Stats.TotalDuration()),
+							Duration("total_cpu_time", info.Stats.TotalCPUTime()),
+						)
+					}
+					l.Log(ctx, "done", fields...)
 				} else {
 					lvl := WARN
 					if !xerrors.IsYdb(info.Error) {
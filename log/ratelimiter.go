@@ -1,10 +1,75 @@
 package log
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 // Ratelimiter returns trace.Ratelimiter with logging events from details
 func Ratelimiter(l Logger, d trace.Detailer, opts ...Option) (t trace.Ratelimiter) {
+	t.OnLimiterAcquire = func(
+		info trace.RatelimiterLimiterAcquireStartInfo,
+	) func(
+		info trace.RatelimiterLimiterAcquireDoneInfo,
+	) {
+		if d.Details()&trace.RatelimiterEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "ratelimiter", "limiter", "acquire")
+		l.Log(ctx, "start",
+			String("coordinationNodePath", info.CoordinationNodePath),
+			String("resourcePath", info.ResourcePath),
+			String("amount", strconv.FormatUint(info.Amount, 10)),
+			Bool("report", info.Report),
+		)
+		start := time.Now()
+
+		return func(info trace.RatelimiterLimiterAcquireDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "fail",
+					latencyField(start),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnLimiterWait = func(
+		info trace.RatelimiterLimiterWaitStartInfo,
+	) func(
+		info trace.RatelimiterLimiterWaitDoneInfo,
+	) {
+		if d.Details()&trace.RatelimiterEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "ratelimiter", "limiter", "wait")
+		l.Log(ctx, "start",
+			String("coordinationNodePath", info.CoordinationNodePath),
+			String("resourcePath", info.ResourcePath),
+			String("amount", strconv.FormatUint(info.Amount, 10)),
+		)
+		start := time.Now()
+
+		return func(info trace.RatelimiterLimiterWaitDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "fail",
+					latencyField(start),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
+
 	return t
 }
@@ -1,9 +1,74 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func ratelimiter(config Config) (t trace.Ratelimiter) {
+	config = config.WithSystem("ratelimiter")
+	limiterConfig := config.WithSystem("limiter")
+	acquireConfig := limiterConfig.WithSystem("acquire")
+	acquireErrs := acquireConfig.CounterVec("errors", "status", "resource", "report")
+	acquireLatency := acquireConfig.TimerVec("latency", "resource", "report")
+	waitConfig := limiterConfig.WithSystem("wait")
+	waitErrs := waitConfig.CounterVec("errors", "status", "resource")
+	waitLatency := waitConfig.TimerVec("latency", "resource")
+	allowed := limiterConfig.CounterVec("allow", "resource", "allowed")
+	t.OnLimiterAcquire = func(info trace.RatelimiterLimiterAcquireStartInfo) func(trace.RatelimiterLimiterAcquireDoneInfo) {
+		if config.Details()&trace.RatelimiterEvents == 0 {
+			return nil
+		}
+		resource := info.CoordinationNodePath + "/" + info.ResourcePath
+		report := "false"
+		if info.Report {
+			report = "true"
+		}
+		start := time.Now()
+
+		return func(info trace.RatelimiterLimiterAcquireDoneInfo) {
+			acquireErrs.With(map[string]string{
+				"status":   errorBrief(info.Error),
+				"resource": resource,
+				"report":   report,
+			}).Inc()
+			acquireLatency.With(map[string]string{
+				"resource": resource,
+				"report":   report,
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnLimiterWait = func(info trace.RatelimiterLimiterWaitStartInfo) func(trace.RatelimiterLimiterWaitDoneInfo) {
+		if config.Details()&trace.RatelimiterEvents == 0 {
+			return nil
+		}
+		resource := info.CoordinationNodePath + "/" + info.ResourcePath
+		start := time.Now()
+
+		return func(info trace.RatelimiterLimiterWaitDoneInfo) {
+			waitErrs.With(map[string]string{
+				"status":   errorBrief(info.Error),
+				"resource": resource,
+			}).Inc()
+			waitLatency.With(map[string]string{
+				"resource": resource,
+			}).Record(time.Since(start))
+		}
+	}
+	t.OnLimiterAllow = func(info trace.RatelimiterLimiterAllowInfo) {
+		if config.Details()&trace.RatelimiterEvents == 0 {
+			return
+		}
+		result := "false"
+		if info.Allowed {
+			result = "true"
+		}
+		allowed.With(map[string]string{
+			"resource": info.CoordinationNodePath + "/" + info.ResourcePath,
+			"allowed":  result,
+		}).Inc()
+	}
+
 	return t
 }
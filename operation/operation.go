@@ -0,0 +1,62 @@
+package operation
+
+import (
+	"context"
+
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+)
+
+// Client is a client of operation service for long-running operations (index building, import, export,
+// script execution)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Client interface {
+	// Get returns state of operation by ID.
+	// Not ready operation is not an error. Ready operation with non-success status returns
+	// without error and with filled Operation.Err()
+	Get(ctx context.Context, opID string) (*Operation, error)
+
+	// List returns page of operations of given kind (see Kind* constants).
+	// Next page may be requested with WithPageToken(ListResult.NextToken) until NextToken is empty
+	List(ctx context.Context, kind string, opts ...ListOption) (*ListResult, error)
+
+	// Cancel starts cancellation of operation
+	Cancel(ctx context.Context, opID string) error
+
+	// Forget forgets operation. Operation becomes unavailable for Get and List after Forget
+	Forget(ctx context.Context, opID string) error
+
+	// Wait polls operation with backoff until operation becomes ready or context is done.
+	// Ready operation with non-success status returns as error
+	Wait(ctx context.Context, opID string) (*Operation, error)
+}
+
+type (
+	// Operation is a state of long-running operation
+	Operation = internalOperation.Operation
+
+	// ListResult is a page of operations
+	ListResult = internalOperation.ListResult
+
+	// ListOption specified settings of List request
+	ListOption = internalOperation.ListOption
+)
+
+// Kinds of long-running operations for List
+const (
+	KindBuildIndex = "buildindex"
+	KindExportS3   = "export/s3"
+	KindExportYT   = "export/yt"
+	KindImportS3   = "import/s3"
+	KindScriptExec = "scriptexec"
+)
+
+// WithPageSize sets max count of operations in one page of List
+func WithPageSize(pageSize uint64) ListOption {
+	return internalOperation.WithPageSize(pageSize)
+}
+
+// WithPageToken sets token of requested page of List from ListResult.NextToken of previous page
+func WithPageToken(pageToken string) ListOption {
+	return internalOperation.WithPageToken(pageToken)
+}
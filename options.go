@@ -17,6 +17,7 @@ import (
 	coordinationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	ratelimiterConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
 	schemeConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
@@ -441,6 +442,7 @@ func WithSessionPoolSizeLimit(sizeLimit int) Option {
 func WithSessionPoolIdleThreshold(idleThreshold time.Duration) Option {
 	return func(ctx context.Context, c *Driver) error {
 		c.tableOptions = append(c.tableOptions, tableConfig.WithIdleThreshold(idleThreshold))
+		c.queryOptions = append(c.queryOptions, queryConfig.WithPoolIdleThreshold(idleThreshold))
 		c.databaseSQLOptions = append(
 			c.databaseSQLOptions,
 			xsql.WithIdleThreshold(idleThreshold),
@@ -450,6 +452,18 @@ func WithSessionPoolIdleThreshold(idleThreshold time.Duration) Option {
 	}
 }
 
+// WithSessionPoolMinIdle set count of idle sessions which query.Client creates on start
+// and keeps in pool regardless of idle threshold
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionPoolMinIdle(minIdle int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.queryOptions = append(c.queryOptions, queryConfig.WithPoolMinIdle(minIdle))
+
+		return nil
+	}
+}
+
 // WithSessionPoolCreateSessionTimeout set timeout for new session creation process in table.Client
 func WithSessionPoolCreateSessionTimeout(createSessionTimeout time.Duration) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -589,6 +603,28 @@ func WithTraceScheme(t trace.Scheme, opts ...trace.SchemeComposeOption) Option {
 	}
 }
 
+// WithTraceOperation returns operation trace option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTraceOperation(t trace.Operation, opts ...trace.OperationComposeOption) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.operationOptions = append(
+			c.operationOptions,
+			operationConfig.WithTrace(
+				t,
+				append(
+					[]trace.OperationComposeOption{
+						trace.WithOperationPanicCallback(c.panicCallback),
+					},
+					opts...,
+				)...,
+			),
+		)
+
+		return nil
+	}
+}
+
 // WithTraceCoordination returns coordination trace option
 func WithTraceCoordination(t trace.Coordination, opts ...trace.CoordinationComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
@@ -28,10 +29,61 @@ type Client interface {
 	// DoTx makes auto selector (with TransactionSettings, by default - SerializableReadWrite), commit and
 	// rollback (on error) of transaction.
 	//
+	// Transaction begins lazily with first execute (BeginTx inlined into TransactionControl of execute),
+	// so transaction ID is empty until first execute. Execute with WithCommit option commits transaction
+	// together with query and makes final commit call unnecessary.
+	//
 	// If op TxOperation returns nil - transaction will be committed
 	// If op TxOperation return non nil - transaction will be rollback
 	// Warning: if context without deadline or cancellation func than DoTx can run indefinitely
 	DoTx(ctx context.Context, op TxOperation, opts ...options.DoTxOption) error
+
+	// ReadRow is a helper which executes query with retries and reads exactly one row
+	// from exactly one result set of result.
+	// Returned row is fully materialized in memory and session is released to pool before return.
+	// ReadRow returns error if result contains not exactly one result set or result set
+	// contains not exactly one row
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ReadRow(ctx context.Context, query string, opts ...options.ReadOption) (Row, error)
+
+	// ReadResultSet is a helper which executes query with retries and reads exactly one result set of result.
+	// Returned result set is fully materialized in memory and session is released to pool before return.
+	// ReadResultSet returns error if result contains not exactly one result set
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ReadResultSet(ctx context.Context, query string, opts ...options.ReadOption) (ResultSet, error)
+
+	// ExecuteScript starts long-running script execution and returns operation without waiting of script finish.
+	// Results of script are stored on server side during ttl and can be fetched with FetchScriptResults
+	// by operation ID, including after client restart
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ExecuteScript(
+		ctx context.Context, query string, ttl time.Duration, opts ...options.ExecuteOption,
+	) (*ExecuteScriptOperation, error)
+
+	// FetchScriptResults fetches page of rows of script result set.
+	// Result set index and page token defines with WithResultSetIndex and WithFetchToken options
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	FetchScriptResults(ctx context.Context, opID string, opts ...options.FetchScriptOption) (*FetchScriptResult, error)
+
+	// GetScriptOperation returns actual state of script execution operation for poll.
+	// Returns operation error if script execution finished with failure
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	GetScriptOperation(ctx context.Context, opID string) (*ExecuteScriptOperation, error)
+
+	// CancelScriptOperation cancels script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CancelScriptOperation(ctx context.Context, opID string) error
+
+	// ForgetScriptOperation forgets script execution operation and releases script results on server side
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ForgetScriptOperation(ctx context.Context, opID string) error
 }
 
 type (
@@ -53,6 +105,7 @@ type (
 	bothDoAndDoTxOption interface {
 		options.DoOption
 		options.DoTxOption
+		options.ReadOption
 	}
 )
 
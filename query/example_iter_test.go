@@ -0,0 +1,52 @@
+//go:build go1.23
+
+package query_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func Example_rows() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	type myStruct struct {
+		ID  int32  `sql:"id"`
+		Str string `sql:"myStr"`
+	}
+	var values []myStruct
+	// Do retry operation on errors with best effort
+	err = db.Query().Do(ctx, // context manage exiting from Do
+		func(ctx context.Context, s query.Session) (err error) { // retry operation
+			_, res, err := s.Execute(ctx,
+				`SELECT 42 as id, "my string" as myStr`,
+			)
+			if err != nil {
+				return err // for auto-retry with driver
+			}
+			values = values[:0]
+			for v, err := range query.Rows[myStruct](ctx, res) { // result closes on finish of iteration
+				if err != nil {
+					return err
+				}
+				values = append(values, v)
+			}
+
+			return nil
+		},
+		query.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+	fmt.Printf("values=%v\n", values)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
@@ -196,3 +197,41 @@ func Example_txSelect() {
 	}
 	fmt.Printf("id=%v, myStr='%s'\n", id, myStr)
 }
+
+func Example_selectWithPostgreSQLSyntax() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	parameters, err := query.PgArgs(int32(42), "my string")
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+
+		return
+	}
+	row, err := db.Query().ReadRow(ctx,
+		`SELECT $1::int4 AS id, $2::text AS myStr, now() AS ts`,
+		query.WithSyntax(query.SyntaxPostgreSQL),
+		query.WithParameters(parameters),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+
+		return
+	}
+	var (
+		id    int32
+		myStr string
+		ts    time.Time
+	)
+	if err = row.Scan(&id, &myStr, &ts); err != nil { // postgres values scans into native go types
+		fmt.Printf("unexpected error: %v", err)
+
+		return
+	}
+	fmt.Printf("id=%v, myStr='%s', ts=%v\n", id, myStr, ts)
+}
@@ -0,0 +1,79 @@
+//go:build go1.23
+
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+)
+
+// Rows returns iterator over rows of all result sets of result r.
+// Each row scanned into new value of type T with Row.ScanStruct.
+//
+// Result r closes on finish of iteration, including early break of range loop.
+// Iteration stops after first yielded error
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Rows[T any](ctx context.Context, r Result, opts ...scanner.ScanStructOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer func() {
+			_ = r.Close(ctx)
+		}()
+		for {
+			rs, err := r.NextResultSet(ctx)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+			for v, err := range ResultSetRows[T](ctx, rs, opts...) {
+				if !yield(v, err) || err != nil {
+					return
+				}
+			}
+		}
+		if err := r.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// ResultSetRows returns iterator over rows of result set rs.
+// Each row scanned into new value of type T with Row.ScanStruct.
+// Iteration stops after first yielded error.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ResultSetRows[T any](ctx context.Context, rs ResultSet, opts ...scanner.ScanStructOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+			var v T
+			if err = row.ScanStruct(&v, opts...); err != nil {
+				yield(v, err)
+
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
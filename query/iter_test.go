@@ -0,0 +1,171 @@
+//go:build go1.23
+
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type testRow struct {
+	id  uint64
+	err error
+}
+
+func (r testRow) Scan(...interface{}) error {
+	return nil
+}
+
+func (r testRow) ScanNamed(...scanner.NamedDestination) error {
+	return nil
+}
+
+func (r testRow) ScanStruct(dst interface{}, _ ...scanner.ScanStructOption) error {
+	if r.err != nil {
+		return r.err
+	}
+	dst.(*testStruct).ID = r.id
+
+	return nil
+}
+
+type testResultSet struct {
+	rows []testRow
+}
+
+func (rs *testResultSet) Index() int {
+	return 0
+}
+
+func (rs *testResultSet) Columns() []string {
+	return []string{"id"}
+}
+
+func (rs *testResultSet) ColumnTypes() []types.Type {
+	return []types.Type{types.TypeUint64}
+}
+
+func (rs *testResultSet) NextRow(context.Context) (Row, error) {
+	if len(rs.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := rs.rows[0]
+	rs.rows = rs.rows[1:]
+
+	return row, nil
+}
+
+type testResult struct {
+	resultSets []*testResultSet
+	err        error
+	closed     bool
+}
+
+func (r *testResult) Close(context.Context) error {
+	r.closed = true
+
+	return nil
+}
+
+func (r *testResult) NextResultSet(context.Context) (ResultSet, error) {
+	if len(r.resultSets) == 0 {
+		return nil, io.EOF
+	}
+	rs := r.resultSets[0]
+	r.resultSets = r.resultSets[1:]
+
+	return rs, nil
+}
+
+func (r *testResult) Err() error {
+	return r.err
+}
+
+func (r *testResult) Stats() stats.QueryStats {
+	return nil
+}
+
+type testStruct struct {
+	ID uint64 `sql:"id"`
+}
+
+func TestRows(t *testing.T) {
+	ctx := context.Background()
+	t.Run("HappyWay", func(t *testing.T) {
+		r := &testResult{
+			resultSets: []*testResultSet{
+				{rows: []testRow{{id: 1}, {id: 2}}},
+				{rows: []testRow{{id: 3}}},
+			},
+		}
+		var ids []uint64
+		for v, err := range Rows[testStruct](ctx, r) {
+			require.NoError(t, err)
+			ids = append(ids, v.ID)
+		}
+		require.Equal(t, []uint64{1, 2, 3}, ids)
+		require.True(t, r.closed)
+	})
+	t.Run("Break", func(t *testing.T) {
+		r := &testResult{
+			resultSets: []*testResultSet{
+				{rows: []testRow{{id: 1}, {id: 2}}},
+			},
+		}
+		for v, err := range Rows[testStruct](ctx, r) {
+			require.NoError(t, err)
+			require.EqualValues(t, 1, v.ID)
+
+			break
+		}
+		require.True(t, r.closed)
+	})
+	t.Run("ScanError", func(t *testing.T) {
+		errScan := errors.New("scan error")
+		r := &testResult{
+			resultSets: []*testResultSet{
+				{rows: []testRow{{id: 1}, {err: errScan}, {id: 3}}},
+			},
+		}
+		var (
+			ids  []uint64
+			errs []error
+		)
+		for v, err := range Rows[testStruct](ctx, r) {
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+			ids = append(ids, v.ID)
+		}
+		require.Equal(t, []uint64{1}, ids)
+		require.Equal(t, []error{errScan}, errs)
+		require.True(t, r.closed)
+	})
+	t.Run("ResultError", func(t *testing.T) {
+		errResult := errors.New("result error")
+		r := &testResult{
+			resultSets: []*testResultSet{
+				{rows: []testRow{{id: 1}}},
+			},
+			err: errResult,
+		}
+		var errs []error
+		for _, err := range Rows[testStruct](ctx, r) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		require.Equal(t, []error{errResult}, errs)
+		require.True(t, r.closed)
+	})
+}
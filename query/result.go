@@ -5,6 +5,8 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
 type (
@@ -13,8 +15,24 @@ type (
 
 		NextResultSet(ctx context.Context) (ResultSet, error)
 		Err() error
+
+		// Stats returns query execution statistics and plan or nil if server not sent statistics.
+		// Statistics requires query.WithStatsMode option (or query.WithExecMode(query.ExecModeExplain)
+		// for query plan only) and becomes available after reading all result sets
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Stats() stats.QueryStats
 	}
 	ResultSet interface {
+		// Index returns index of result set in query result
+		Index() int
+
+		// Columns returns column names of result set
+		Columns() []string
+
+		// ColumnTypes returns YDB types of result set columns in the same order as Columns
+		ColumnTypes() []types.Type
+
 		NextRow(ctx context.Context) (Row, error)
 	}
 	Row interface {
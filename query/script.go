@@ -0,0 +1,82 @@
+package query
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	// ExecuteScriptOperation describes long-running operation of script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ExecuteScriptOperation struct {
+		// ID is an operation ID which must be used for fetch script results and manage operation
+		ID            string
+		Ready         bool
+		ConsumedUnits float64
+		Metadata      *ScriptMetadata
+	}
+
+	// ScriptMetadata describes state of script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptMetadata struct {
+		ExecutionID    string
+		Status         options.ExecStatus
+		Script         string
+		ExecMode       options.ExecMode
+		ResultSetsMeta []ScriptResultSetMeta
+		Stats          stats.QueryStats
+	}
+
+	// ScriptResultSetMeta describes columns of script result set
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptResultSetMeta struct {
+		Columns     []string
+		ColumnTypes []types.Type
+	}
+
+	// FetchScriptResult is a page of rows from script result set
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	FetchScriptResult struct {
+		ResultSetIndex int64
+		ResultSet      ResultSet
+
+		// NextToken is a token for fetch next page of rows with WithFetchToken option.
+		// Empty NextToken means that all rows of result set are fetched
+		NextToken string
+	}
+)
+
+const (
+	ExecStatusUnspecified = options.ExecStatusUnspecified
+	ExecStatusStarting    = options.ExecStatusStarting
+	ExecStatusAborted     = options.ExecStatusAborted
+	ExecStatusCanceled    = options.ExecStatusCanceled
+	ExecStatusCompleted   = options.ExecStatusCompleted
+	ExecStatusFailed      = options.ExecStatusFailed
+)
+
+// WithResultSetIndex sets index of result set for fetch with Client.FetchScriptResults
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultSetIndex(index int64) options.FetchScriptOption {
+	return options.WithResultSetIndex(index)
+}
+
+// WithFetchToken sets token of page for fetch with Client.FetchScriptResults
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFetchToken(token string) options.FetchScriptOption {
+	return options.WithFetchToken(token)
+}
+
+// WithRowsLimit sets max rows count of page for fetch with Client.FetchScriptResults
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRowsLimit(limit int64) options.FetchScriptOption {
+	return options.WithRowsLimit(limit)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
 type (
@@ -54,6 +55,20 @@ func WithParameters(parameters *params.Parameters) options.ParametersOption {
 	return options.WithParameters(parameters)
 }
 
+// PgArgs makes parameters for $1, $2, ... placeholders of query with PostgreSQL syntax (see WithSyntax)
+// from go values. Go values converts to postgres values (int32 as int4, string as text,
+// time.Time as timestamptz, etc.), nil pointers converts to NULL
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func PgArgs(args ...interface{}) (*params.Parameters, error) {
+	parameters, err := params.PgArgs(args...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &parameters, nil
+}
+
 func WithTxControl(txControl *tx.Control) options.TxControlOption {
 	return options.WithTxControl(txControl)
 }
@@ -62,6 +77,8 @@ func WithTxSettings(txSettings tx.Settings) options.DoTxOption {
 	return options.WithTxSettings(txSettings)
 }
 
+// WithCommit returns execute option which commits transaction together with execute of query.
+// Transaction can not be used for execute after execute with WithCommit option
 func WithCommit() options.TxExecuteOption {
 	return options.WithCommit()
 }
@@ -0,0 +1,54 @@
+package stats
+
+import "time"
+
+type (
+	// QueryStats holds query execution statistics.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	QueryStats interface {
+		ProcessCPUTime() time.Duration
+		Compilation() *CompilationStats
+		QueryPlan() string
+		QueryAST() string
+		TotalCPUTime() time.Duration
+		TotalDuration() time.Duration
+
+		// QueryPhases returns execution phases of query.
+		QueryPhases() []QueryPhase
+	}
+
+	// QueryPhase holds query execution phase statistics.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	QueryPhase interface {
+		Duration() time.Duration
+		CPUTime() time.Duration
+		AffectedShards() uint64
+		IsLiteralPhase() bool
+
+		// TableAccess returns accessed tables within query execution phase.
+		TableAccess() []TableAccess
+	}
+
+	// CompilationStats holds query compilation statistics.
+	CompilationStats struct {
+		FromCache bool
+		Duration  time.Duration
+		CPUTime   time.Duration
+	}
+
+	// TableAccess contains query execution phase's table access statistics.
+	TableAccess struct {
+		Name            string
+		Reads           OperationStats
+		Updates         OperationStats
+		Deletes         OperationStats
+		PartitionsCount uint64
+	}
+
+	OperationStats struct {
+		Rows  uint64
+		Bytes uint64
+	}
+)
@@ -0,0 +1,410 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	defaultLimiterBatchSize  = 100
+	defaultLimiterRetryDelay = 100 * time.Millisecond
+)
+
+// ErrLimiterClosed is returned by Limiter.Wait if the limiter is closed.
+var ErrLimiterClosed = xerrors.Wrap(errors.New("ydb: ratelimiter limiter is closed"))
+
+// LimiterOption is an option of the Limiter.
+type LimiterOption func(l *Limiter)
+
+// WithLimiterBatchSize returns a LimiterOption that specifies the amount of units acquired from the server by one
+// request. The batch size must not exceed the maximum burst of the resource, otherwise acquiring never succeeds.
+//
+// If this is not set, the limiter acquires 100 units at a time.
+func WithLimiterBatchSize(batchSize uint64) LimiterOption {
+	return func(l *Limiter) {
+		if batchSize > 0 {
+			l.batchSize = batchSize
+		}
+	}
+}
+
+// WithLimiterRetryDelay returns a LimiterOption that specifies the delay before the next attempt to acquire units if
+// the previous attempt failed.
+//
+// If this is not set, the limiter waits 100 milliseconds.
+func WithLimiterRetryDelay(delay time.Duration) LimiterOption {
+	return func(l *Limiter) {
+		l.retryDelay = delay
+	}
+}
+
+// WithLimiterTrace appends the trace of the limiter events to early defined traces.
+func WithLimiterTrace(t trace.Ratelimiter, opts ...trace.RatelimiterComposeOption) LimiterOption {
+	return func(l *Limiter) {
+		l.trace = l.trace.Compose(&t, opts...)
+	}
+}
+
+// Limiter is a client-side token bucket of the rate limiter resource. It acquires units from the server in batches
+// in the background and serves Wait and Allow calls locally, so the calls do not make a request per acquisition.
+// The next batch is prefetched when less than a half of the batch is left.
+//
+// Units consumed without permission (see Report) are reported to the server in the report mode.
+//
+// Retryable errors of the server requests are retried in the background. A non-retryable error (for example, the
+// resource is not found) fails the limiter: pending and later Wait calls return the error.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Limiter struct {
+	client               Client
+	coordinationNodePath string
+	resourcePath         string
+	batchSize            uint64
+	retryDelay           time.Duration
+	trace                *trace.Ratelimiter
+
+	ctx     context.Context //nolint:containedctx
+	cancel  context.CancelFunc
+	refill  chan struct{}
+	stopped chan struct{}
+
+	m       sync.Mutex // guards the fields below
+	closed  bool
+	err     error // non-retryable error of the background acquiring
+	tokens  uint64
+	overuse uint64
+	waiters []*limiterWaiter
+}
+
+type limiterWaiter struct {
+	amount  uint64
+	granted chan struct{}
+	err     error
+}
+
+// NewLimiter creates a limiter of the resource and starts the background acquiring of units. The limiter must be
+// closed after use.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewLimiter(client Client, coordinationNodePath, resourcePath string, opts ...LimiterOption) *Limiter {
+	ctx, cancel := xcontext.WithCancel(context.Background())
+	l := &Limiter{
+		client:               client,
+		coordinationNodePath: coordinationNodePath,
+		resourcePath:         resourcePath,
+		batchSize:            defaultLimiterBatchSize,
+		retryDelay:           defaultLimiterRetryDelay,
+		trace:                &trace.Ratelimiter{},
+		ctx:                  ctx,
+		cancel:               cancel,
+		refill:               make(chan struct{}, 1),
+		stopped:              make(chan struct{}),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(l)
+		}
+	}
+
+	go l.refillLoop()
+	l.requestRefill()
+
+	return l
+}
+
+// Allow takes n units if they are available locally without waiting and reports whether they were taken.
+func (l *Limiter) Allow(n uint64) bool {
+	l.m.Lock()
+	allowed := !l.closed && l.err == nil && len(l.waiters) == 0 && l.tokens >= n
+	if allowed {
+		l.tokens -= n
+	}
+	needRefill := l.needRefillLocked()
+	l.m.Unlock()
+
+	if needRefill {
+		l.requestRefill()
+	}
+	trace.RatelimiterOnLimiterAllow(l.trace, l.coordinationNodePath, l.resourcePath, n, allowed)
+
+	return allowed
+}
+
+// Wait blocks until n units are available and takes them. Waiters are served in the FIFO order.
+// Wait returns an error if the limiter is closed or failed by a non-retryable error of acquiring.
+func (l *Limiter) Wait(ctx context.Context, n uint64) (finalErr error) {
+	onDone := trace.RatelimiterOnLimiterWait(l.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/ratelimiter.(*Limiter).Wait"),
+		l.coordinationNodePath, l.resourcePath, n,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	l.m.Lock()
+	if l.closed {
+		l.m.Unlock()
+
+		return xerrors.WithStackTrace(ErrLimiterClosed)
+	}
+	if l.err != nil {
+		err := l.err
+		l.m.Unlock()
+
+		return xerrors.WithStackTrace(err)
+	}
+	if len(l.waiters) == 0 && l.tokens >= n {
+		l.tokens -= n
+		needRefill := l.needRefillLocked()
+		l.m.Unlock()
+
+		if needRefill {
+			l.requestRefill()
+		}
+
+		return nil
+	}
+	w := &limiterWaiter{
+		amount:  n,
+		granted: make(chan struct{}),
+	}
+	l.waiters = append(l.waiters, w)
+	l.m.Unlock()
+
+	l.requestRefill()
+
+	select {
+	case <-w.granted:
+		return w.err
+	case <-ctx.Done():
+		l.m.Lock()
+		defer l.m.Unlock()
+
+		select {
+		case <-w.granted:
+			// The units were granted concurrently with the cancellation, so there is no reason to lose them.
+			return w.err
+		default:
+		}
+
+		for i := range l.waiters {
+			if l.waiters[i] == w {
+				l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+
+				break
+			}
+		}
+		// The canceled waiter may block the next ones.
+		l.serveLocked()
+
+		return xerrors.WithStackTrace(ctx.Err())
+	}
+}
+
+// Report takes n units which were consumed without waiting for them. The units that exceed the locally available
+// ones are reported to the server in the report mode in the background.
+func (l *Limiter) Report(n uint64) {
+	l.m.Lock()
+	if n <= l.tokens {
+		l.tokens -= n
+	} else {
+		l.overuse += n - l.tokens
+		l.tokens = 0
+	}
+	l.m.Unlock()
+
+	l.requestRefill()
+}
+
+// Close stops the background acquiring of units and fails all pending Wait calls with ErrLimiterClosed. Units which
+// were reported but not sent to the server yet are reported synchronously. Units which were acquired but not taken
+// are lost.
+func (l *Limiter) Close(ctx context.Context) error {
+	l.m.Lock()
+	if l.closed {
+		l.m.Unlock()
+
+		return nil
+	}
+	l.closed = true
+	for _, w := range l.waiters {
+		w.err = xerrors.WithStackTrace(ErrLimiterClosed)
+		close(w.granted)
+	}
+	l.waiters = nil
+	l.m.Unlock()
+
+	l.cancel()
+
+	select {
+	case <-l.stopped:
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	}
+
+	l.m.Lock()
+	overuse := l.overuse
+	l.overuse = 0
+	l.m.Unlock()
+
+	if overuse > 0 {
+		return xerrors.WithStackTrace(l.acquire(ctx, overuse, true))
+	}
+
+	return nil
+}
+
+func (l *Limiter) requestRefill() {
+	select {
+	case l.refill <- struct{}{}:
+	default:
+	}
+}
+
+func (l *Limiter) refillLoop() {
+	defer close(l.stopped)
+
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-l.refill:
+		}
+
+		for l.refillOnce() {
+		}
+	}
+}
+
+// refillOnce reports the overused units and acquires the next batch of units if it is needed. It returns false if
+// nothing more is needed at the moment or the limiter is closed.
+func (l *Limiter) refillOnce() bool {
+	l.m.Lock()
+	overuse := l.overuse
+	l.overuse = 0
+	amount := l.acquireAmountLocked()
+	l.m.Unlock()
+
+	if overuse > 0 {
+		if err := l.acquire(l.ctx, overuse, true); err != nil {
+			l.m.Lock()
+			l.overuse += overuse
+			l.m.Unlock()
+
+			return l.retryOrFail(err)
+		}
+	}
+
+	if amount == 0 {
+		return false
+	}
+
+	if err := l.acquire(l.ctx, amount, false); err != nil {
+		return l.retryOrFail(err)
+	}
+
+	l.m.Lock()
+	l.tokens += amount
+	l.serveLocked()
+	l.m.Unlock()
+
+	return true
+}
+
+func (l *Limiter) acquire(ctx context.Context, amount uint64, report bool) (finalErr error) {
+	onDone := trace.RatelimiterOnLimiterAcquire(l.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/ratelimiter.(*Limiter).acquire"),
+		l.coordinationNodePath, l.resourcePath, amount, report,
+	)
+	defer func() {
+		onDone(finalErr)
+	}()
+
+	if report {
+		return l.client.AcquireResource(ctx, l.coordinationNodePath, l.resourcePath, amount, WithReport())
+	}
+
+	return l.client.AcquireResource(ctx, l.coordinationNodePath, l.resourcePath, amount, WithAcquire())
+}
+
+// retryOrFail waits before the next attempt if the error is retryable, otherwise it fails the limiter.
+func (l *Limiter) retryOrFail(err error) bool {
+	if !retry.Check(err).MustRetry(true) {
+		l.fail(err)
+
+		return false
+	}
+
+	return l.waitRetry()
+}
+
+// fail fails pending and later Wait calls with the non-retryable error.
+func (l *Limiter) fail(err error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	if l.closed || l.err != nil {
+		return
+	}
+	l.err = fmt.Errorf("ydb: ratelimiter limiter failed: %w", err)
+	for _, w := range l.waiters {
+		w.err = xerrors.WithStackTrace(l.err)
+		close(w.granted)
+	}
+	l.waiters = nil
+}
+
+func (l *Limiter) waitRetry() bool {
+	timer := time.NewTimer(l.retryDelay)
+	defer timer.Stop()
+
+	select {
+	case <-l.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// acquireAmountLocked returns the amount of units which should be acquired to serve all waiters and keep at least a
+// half of the batch prefetched.
+func (l *Limiter) acquireAmountLocked() uint64 {
+	if l.closed || l.err != nil {
+		return 0
+	}
+
+	var demand uint64
+	for _, w := range l.waiters {
+		demand += w.amount
+	}
+	if demand > l.tokens {
+		return max(demand-l.tokens, l.batchSize)
+	}
+	if l.tokens-demand < l.batchSize/2 { //nolint:gomnd
+		return l.batchSize
+	}
+
+	return 0
+}
+
+func (l *Limiter) needRefillLocked() bool {
+	return l.overuse > 0 || l.acquireAmountLocked() > 0
+}
+
+func (l *Limiter) serveLocked() {
+	for len(l.waiters) > 0 && l.tokens >= l.waiters[0].amount {
+		w := l.waiters[0]
+		l.waiters = l.waiters[1:]
+		l.tokens -= w.amount
+		close(w.granted)
+	}
+}
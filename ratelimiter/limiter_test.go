@@ -0,0 +1,280 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// testClient grants units immediately unless it is paused.
+type testClient struct {
+	Client
+
+	m        sync.Mutex
+	paused   bool
+	err      error
+	acquired []uint64
+	reported []uint64
+	resumed  chan struct{}
+}
+
+func newTestClient() *testClient {
+	return &testClient{resumed: make(chan struct{})}
+}
+
+func (c *testClient) AcquireResource(
+	ctx context.Context,
+	coordinationNodePath string,
+	resourcePath string,
+	amount uint64,
+	opts ...options.AcquireOption,
+) error {
+	c.m.Lock()
+	for c.paused {
+		resumed := c.resumed
+		c.m.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-resumed:
+		}
+		c.m.Lock()
+	}
+	defer c.m.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+	if options.NewAcquire(opts...).Type() == options.AcquireTypeReport {
+		c.reported = append(c.reported, amount)
+	} else {
+		c.acquired = append(c.acquired, amount)
+	}
+
+	return nil
+}
+
+func (c *testClient) pause() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.paused = true
+}
+
+func (c *testClient) resume() {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.paused = false
+	close(c.resumed)
+	c.resumed = make(chan struct{})
+}
+
+func (c *testClient) setError(err error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.err = err
+}
+
+func (c *testClient) totals() (acquired, reported uint64, requests int) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for _, amount := range c.acquired {
+		acquired += amount
+	}
+	for _, amount := range c.reported {
+		reported += amount
+	}
+
+	return acquired, reported, len(c.acquired)
+}
+
+func TestLimiter(t *testing.T) {
+	t.Run("Batching", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+		defer func() {
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		for i := 0; i < 100; i++ {
+			require.NoError(t, l.Wait(ctx, 1))
+		}
+
+		acquired, _, requests := client.totals()
+		require.GreaterOrEqual(t, acquired, uint64(100))
+		require.LessOrEqual(t, requests, 11)
+	})
+	t.Run("WaitMoreThanBatch", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+		defer func() {
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		require.NoError(t, l.Wait(ctx, 25))
+	})
+	t.Run("Allow", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		client.pause()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+		defer func() {
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		require.False(t, l.Allow(1))
+
+		client.resume()
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return l.Allow(5)
+		})
+		require.False(t, l.Allow(100))
+	})
+	t.Run("WaitFIFO", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		client.pause()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+		defer func() {
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				require.NoError(t, l.Wait(ctx, 10))
+			}()
+			xtest.SpinWaitCondition(t, &l.m, func() bool {
+				return len(l.waiters) == i+1
+			})
+		}
+
+		// the waiters are not overtaken by the smaller request
+		require.False(t, l.Allow(1))
+
+		client.resume()
+		wg.Wait()
+	})
+	t.Run("WaitCanceled", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		client.pause()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+		defer func() {
+			client.resume()
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, l.Wait(waitCtx, 1), context.DeadlineExceeded)
+
+		l.m.Lock()
+		defer l.m.Unlock()
+		require.Empty(t, l.waiters)
+	})
+	t.Run("RetryOnError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		client.setError(xerrors.Retryable(errors.New("test")))
+		l := NewLimiter(client, "/local/node", "resource",
+			WithLimiterBatchSize(10),
+			WithLimiterRetryDelay(time.Millisecond),
+		)
+		defer func() {
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		done := make(chan error, 1)
+		go func() {
+			done <- l.Wait(ctx, 1)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		require.Empty(t, done)
+
+		client.setError(nil)
+		require.NoError(t, <-done)
+	})
+	t.Run("FailOnNonRetryableError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		client.pause()
+		testErr := errors.New("test")
+		client.setError(testErr)
+		l := NewLimiter(client, "/local/node", "resource",
+			WithLimiterBatchSize(10),
+			WithLimiterRetryDelay(time.Millisecond),
+		)
+		defer func() {
+			require.NoError(t, l.Close(ctx))
+		}()
+
+		done := make(chan error, 1)
+		go func() {
+			done <- l.Wait(ctx, 1)
+		}()
+		xtest.SpinWaitCondition(t, &l.m, func() bool {
+			return len(l.waiters) == 1
+		})
+
+		client.resume()
+		require.ErrorIs(t, <-done, testErr)
+		require.ErrorIs(t, l.Wait(ctx, 1), testErr)
+		require.False(t, l.Allow(1))
+	})
+	t.Run("Report", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+
+		require.NoError(t, l.Wait(ctx, 1))
+		l.m.Lock()
+		tokens := l.tokens
+		l.m.Unlock()
+
+		l.Report(tokens + 15)
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			_, reported, _ := client.totals()
+
+			return reported == 15
+		})
+
+		require.NoError(t, l.Close(ctx))
+	})
+	t.Run("Close", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		client := newTestClient()
+		client.pause()
+		l := NewLimiter(client, "/local/node", "resource", WithLimiterBatchSize(10))
+
+		done := make(chan error, 1)
+		go func() {
+			done <- l.Wait(ctx, 1)
+		}()
+		xtest.SpinWaitCondition(t, &l.m, func() bool {
+			return len(l.waiters) == 1
+		})
+
+		require.NoError(t, l.Close(ctx))
+		require.ErrorIs(t, <-done, ErrLimiterClosed)
+		require.ErrorIs(t, l.Wait(ctx, 1), ErrLimiterClosed)
+		require.False(t, l.Allow(1))
+		require.NoError(t, l.Close(ctx))
+	})
+}
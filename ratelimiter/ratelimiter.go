@@ -41,6 +41,16 @@ type Client interface {
 		amount uint64,
 		opts ...options.AcquireOption,
 	) (err error)
+
+	// Limiter returns a client-side token bucket of the resource which acquires units in batches.
+	// The limiter must be closed after use.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Limiter(
+		coordinationNodePath string,
+		resourcePath string,
+		opts ...LimiterOption,
+	) *Limiter
 }
 
 func WithAcquire() options.AcquireOption {
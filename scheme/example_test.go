@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 )
 
 func Example() {
@@ -26,3 +29,36 @@ func Example() {
 	}
 	fmt.Printf("list directory: %+v\n", d)
 }
+
+func ExampleWalk() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	err = scheme.Walk(ctx, db.Scheme(), db.Name(), func(ctx context.Context, e *scheme.WalkEntry) error {
+		if e.Name == ".sys" {
+			return scheme.SkipDir
+		}
+		if e.IsTable() {
+			desc := e.Description.(options.Description) //nolint:forcetypeassert
+			fmt.Printf("table %q has %d columns\n", e.Path, len(desc.Columns))
+		}
+
+		return nil
+	}, scheme.WithWalkDescriber(scheme.EntryTable, func(ctx context.Context, path string) (desc interface{}, err error) {
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			desc, err = s.DescribeTable(ctx, path)
+
+			return err
+		}, table.WithIdempotent())
+
+		return desc, err
+	}))
+	if err != nil {
+		fmt.Printf("failed to walk scheme: %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const defaultWalkConcurrency = 8
+
+var (
+	// SkipDir is used as a return value from WalkFunc to indicate that the directory is to be skipped. It is not
+	// returned as an error by Walk. SkipDir returned for an entry which is not a directory is ignored.
+	SkipDir = errors.New("skip this directory") //nolint:revive,stylecheck
+
+	// SkipAll is used as a return value from WalkFunc to indicate that the rest of entries are to be skipped. It is
+	// not returned as an error by Walk.
+	SkipAll = errors.New("skip everything and stop the walk") //nolint:revive,stylecheck
+)
+
+// WalkEntry is an entry of the scheme visited by Walk.
+type WalkEntry struct {
+	Entry
+
+	// Path is the absolute path of the entry.
+	Path string
+
+	// Description is the result of the Describer registered for the entry type with WithWalkDescriber or nil.
+	Description interface{}
+}
+
+// WalkFunc is the function called by Walk for each visited entry. The function is called concurrently from
+// several goroutines. If the function returns an error other than SkipDir, the walk is stopped and Walk returns
+// the error.
+type WalkFunc func(ctx context.Context, entry *WalkEntry) error
+
+// Describer describes the entry with the given absolute path. Use it to get the type-specific description of
+// tables, topics or coordination nodes with the corresponding clients.
+type Describer func(ctx context.Context, path string) (description interface{}, err error)
+
+// WalkOption is an option of Walk.
+type WalkOption func(o *walkOptions)
+
+type walkOptions struct {
+	concurrency int
+	types       map[EntryType]struct{}
+	describers  map[EntryType]Describer
+}
+
+// WithWalkConcurrency returns a WalkOption that limits the number of concurrent requests made by Walk.
+//
+// If this is not set, at most 8 requests are made concurrently.
+func WithWalkConcurrency(concurrency int) WalkOption {
+	return func(o *walkOptions) {
+		if concurrency > 0 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+// WithWalkEntryTypes returns a WalkOption that restricts the WalkFunc calls to entries of the given types.
+// Directories are descended regardless of the filter.
+func WithWalkEntryTypes(types ...EntryType) WalkOption {
+	return func(o *walkOptions) {
+		if o.types == nil {
+			o.types = make(map[EntryType]struct{}, len(types))
+		}
+		for _, t := range types {
+			o.types[t] = struct{}{}
+		}
+	}
+}
+
+// WithWalkDescriber returns a WalkOption that registers the describer of entries of the given type. The describer
+// is called before the WalkFunc and the result is passed with WalkEntry.Description.
+func WithWalkDescriber(t EntryType, describe Describer) WalkOption {
+	return func(o *walkOptions) {
+		if o.describers == nil {
+			o.describers = make(map[EntryType]Describer)
+		}
+		o.describers[t] = describe
+	}
+}
+
+// Walk walks the scheme tree rooted at root and calls fn for each entry including the root. Directories are listed
+// concurrently, so the order of fn calls is not defined except the directory itself is visited before its entries.
+// If fn returns SkipDir for a directory, its entries are not listed.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Walk(ctx context.Context, client Client, root string, fn WalkFunc, opts ...WalkOption) error {
+	o := walkOptions{
+		concurrency: defaultWalkConcurrency,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	w := &walker{
+		client:  client,
+		fn:      fn,
+		options: o,
+		group:   g,
+		limit:   make(chan struct{}, o.concurrency),
+	}
+
+	g.Go(func() error {
+		var entry Entry
+		err := w.do(ctx, func() (err error) {
+			entry, err = client.DescribePath(ctx, root)
+
+			return err
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot describe path %q: %w", root, err))
+		}
+
+		return w.visit(ctx, root, entry, true)
+	})
+
+	if err := g.Wait(); err != nil && !errors.Is(err, SkipAll) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type walker struct {
+	client  Client
+	fn      WalkFunc
+	options walkOptions
+	group   *errgroup.Group
+	limit   chan struct{}
+}
+
+// do calls f within the limit of concurrent requests.
+func (w *walker) do(ctx context.Context, f func() error) error {
+	select {
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	case w.limit <- struct{}{}:
+	}
+	defer func() {
+		<-w.limit
+	}()
+
+	return f()
+}
+
+func (w *walker) visit(ctx context.Context, p string, entry Entry, root bool) error {
+	if w.matches(entry.Type) {
+		e := &WalkEntry{
+			Entry: entry,
+			Path:  p,
+		}
+		if describe, has := w.options.describers[entry.Type]; has {
+			err := w.do(ctx, func() (err error) {
+				e.Description, err = describe(ctx, p)
+
+				return err
+			})
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("cannot describe %s %q: %w", entry.Type, p, err))
+			}
+		}
+
+		if err := w.fn(ctx, e); err != nil {
+			if errors.Is(err, SkipDir) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	// The root may be a database, nested databases are not descended.
+	if !entry.IsDirectory() && !(root && entry.IsDatabase()) {
+		return nil
+	}
+
+	var dir Directory
+	err := w.do(ctx, func() (err error) {
+		dir, err = w.client.ListDirectory(ctx, p)
+
+		return err
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("listing directory %q failed: %w", p, err))
+	}
+
+	for i := range dir.Children {
+		child := dir.Children[i]
+		w.group.Go(func() error {
+			return w.visit(ctx, path.Join(p, child.Name), child, false)
+		})
+	}
+
+	return nil
+}
+
+func (w *walker) matches(t EntryType) bool {
+	if w.options.types == nil {
+		return true
+	}
+	_, has := w.options.types[t]
+
+	return has
+}
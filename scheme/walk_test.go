@@ -0,0 +1,241 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// testScheme is an in-memory scheme tree with absolute paths as keys.
+type testScheme struct {
+	Client
+
+	entries map[string]EntryType
+
+	m        sync.Mutex
+	active   int
+	maxCalls int
+	listed   []string
+}
+
+func newTestScheme(entries map[string]EntryType) *testScheme {
+	return &testScheme{entries: entries}
+}
+
+func (s *testScheme) call() func() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.active++
+	s.maxCalls = max(s.maxCalls, s.active)
+
+	return func() {
+		s.m.Lock()
+		defer s.m.Unlock()
+
+		s.active--
+	}
+}
+
+func (s *testScheme) DescribePath(ctx context.Context, p string) (Entry, error) {
+	defer s.call()()
+
+	t, has := s.entries[p]
+	if !has {
+		return Entry{}, errors.New("path not found")
+	}
+
+	return Entry{Name: path.Base(p), Type: t}, nil
+}
+
+func (s *testScheme) ListDirectory(ctx context.Context, p string) (Directory, error) {
+	defer s.call()()
+
+	s.m.Lock()
+	s.listed = append(s.listed, p)
+	s.m.Unlock()
+
+	dir := Directory{Entry: Entry{Name: path.Base(p), Type: s.entries[p]}}
+	for child, t := range s.entries {
+		if path.Dir(child) == p && child != p {
+			dir.Children = append(dir.Children, Entry{Name: path.Base(child), Type: t})
+		}
+	}
+
+	return dir, nil
+}
+
+func testTree() map[string]EntryType {
+	return map[string]EntryType{
+		"/local":                    EntryDatabase,
+		"/local/a":                  EntryDirectory,
+		"/local/a/table":            EntryTable,
+		"/local/a/topic":            EntryTopic,
+		"/local/a/b":                EntryDirectory,
+		"/local/a/b/table":          EntryTable,
+		"/local/a/b/node":           EntryCoordinationNode,
+		"/local/c":                  EntryDirectory,
+		"/local/c/table":            EntryColumnTable,
+		"/local/table":              EntryTable,
+		"/local/serverless":         EntryDatabase,
+		"/local/serverless/ignored": EntryTable,
+	}
+}
+
+// testVisited is a set of visited paths collected concurrently.
+type testVisited struct {
+	m     sync.Mutex
+	paths []string
+}
+
+func (v *testVisited) add(p string) {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	v.paths = append(v.paths, p)
+}
+
+func (v *testVisited) sorted() []string {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	sort.Strings(v.paths)
+
+	return v.paths
+}
+
+func TestWalk(t *testing.T) {
+	t.Run("All", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		var visited testVisited
+		err := Walk(ctx, newTestScheme(testTree()), "/local", func(ctx context.Context, e *WalkEntry) error {
+			visited.add(e.Path)
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"/local",
+			"/local/a",
+			"/local/a/b",
+			"/local/a/b/node",
+			"/local/a/b/table",
+			"/local/a/table",
+			"/local/a/topic",
+			"/local/c",
+			"/local/c/table",
+			"/local/serverless",
+			"/local/table",
+		}, visited.sorted())
+	})
+	t.Run("EntryTypes", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		var visited testVisited
+		err := Walk(ctx, newTestScheme(testTree()), "/local", func(ctx context.Context, e *WalkEntry) error {
+			visited.add(e.Path)
+
+			return nil
+		}, WithWalkEntryTypes(EntryTable, EntryColumnTable))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"/local/a/b/table",
+			"/local/a/table",
+			"/local/c/table",
+			"/local/table",
+		}, visited.sorted())
+	})
+	t.Run("SkipDir", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		s := newTestScheme(testTree())
+		var visited testVisited
+		err := Walk(ctx, s, "/local", func(ctx context.Context, e *WalkEntry) error {
+			visited.add(e.Path)
+			if e.Path == "/local/a" || e.IsTable() {
+				return SkipDir
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"/local",
+			"/local/a",
+			"/local/c",
+			"/local/c/table",
+			"/local/serverless",
+			"/local/table",
+		}, visited.sorted())
+		require.NotContains(t, s.listed, "/local/a")
+	})
+	t.Run("SkipAll", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		err := Walk(ctx, newTestScheme(testTree()), "/local", func(ctx context.Context, e *WalkEntry) error {
+			return SkipAll
+		})
+		require.NoError(t, err)
+	})
+	t.Run("Error", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		testErr := errors.New("test")
+		err := Walk(ctx, newTestScheme(testTree()), "/local", func(ctx context.Context, e *WalkEntry) error {
+			if e.IsTopic() {
+				return testErr
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, testErr)
+	})
+	t.Run("RootNotFound", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		err := Walk(ctx, newTestScheme(testTree()), "/unknown", func(ctx context.Context, e *WalkEntry) error {
+			return nil
+		})
+		require.Error(t, err)
+	})
+	t.Run("Describer", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		var visited testVisited
+		err := Walk(ctx, newTestScheme(testTree()), "/local", func(ctx context.Context, e *WalkEntry) error {
+			if e.IsTable() {
+				require.Equal(t, strings.ToUpper(e.Path), e.Description)
+			} else {
+				require.Nil(t, e.Description)
+			}
+			visited.add(e.Path)
+
+			return nil
+		}, WithWalkDescriber(EntryTable, func(ctx context.Context, p string) (interface{}, error) {
+			return strings.ToUpper(p), nil
+		}))
+		require.NoError(t, err)
+		require.Len(t, visited.sorted(), 11)
+	})
+	t.Run("DescriberError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		testErr := errors.New("test")
+		err := Walk(ctx, newTestScheme(testTree()), "/local", func(ctx context.Context, e *WalkEntry) error {
+			return nil
+		}, WithWalkDescriber(EntryTopic, func(ctx context.Context, p string) (interface{}, error) {
+			return nil, testErr
+		}))
+		require.ErrorIs(t, err, testErr)
+	})
+	t.Run("Concurrency", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		s := newTestScheme(testTree())
+		err := Walk(ctx, s, "/local", func(ctx context.Context, e *WalkEntry) error {
+			return nil
+		}, WithWalkConcurrency(1))
+		require.NoError(t, err)
+		require.Equal(t, 1, s.maxCalls)
+	})
+}
@@ -133,6 +133,19 @@ func WithNumericArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.NumericArgs{})
 }
 
+// WithPgArgs switches database/sql connections to queries with PostgreSQL syntax.
+// Args of query binds to $1, $2, ... placeholders as postgres values (int32 as int4, string as text,
+// time.Time as timestamptz, etc.). Scanning of postgres values into native go types supported for
+// common postgres types (int2, int4, int8, float4, float8, numeric, text, bytea, bool, date, timestamp,
+// timestamptz, json, jsonb and uuid).
+// WithPgArgs requires query service connections (WithQueryService(true)) and can't be combined with
+// other query bind options (WithTablePathPrefix, WithAutoDeclare, WithPositionalArgs, WithNumericArgs)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPgArgs() QueryBindConnectorOption {
+	return xsql.WithPgArgs()
+}
+
 func WithDefaultTxControl(txControl *table.TransactionControl) ConnectorOption {
 	return xsql.WithDefaultTxControl(txControl)
 }
@@ -156,6 +169,13 @@ func WithDisableServerBalancer() ConnectorOption {
 	return xsql.WithDisableServerBalancer()
 }
 
+// WithQueryService switches database/sql connections to sessions of query service
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryService(useQueryService bool) ConnectorOption {
+	return xsql.WithQueryService(useQueryService)
+}
+
 type SQLConnector interface {
 	driver.Connector
 
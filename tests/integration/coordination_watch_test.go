@@ -0,0 +1,73 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestCoordinationWatchSemaphore(t *testing.T) {
+	ctx := xtest.Context(t)
+	db := connect(t)
+
+	const nodePath = "/local/coordination/node/watch"
+	const semaphoreName = "watched-semaphore"
+
+	require.NoError(t, db.Coordination().CreateNode(ctx, nodePath, coordination.NodeConfig{
+		SelfCheckPeriodMillis:    1000,
+		SessionGracePeriodMillis: 1000,
+		ReadConsistencyMode:      coordination.ConsistencyModeStrict,
+		AttachConsistencyMode:    coordination.ConsistencyModeStrict,
+		RatelimiterCountersMode:  coordination.RatelimiterCountersModeDetailed,
+	}))
+	t.Cleanup(func() {
+		_ = db.Coordination().DropNode(ctx, nodePath)
+	})
+
+	s, err := db.Coordination().Session(ctx, nodePath)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = s.Close(ctx)
+	})
+
+	require.NoError(t, s.CreateSemaphore(ctx, semaphoreName, 1, options.WithCreateData([]byte("v1"))))
+
+	desc, changes, err := s.WatchSemaphore(ctx, semaphoreName)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), desc.Data)
+
+	waitData := func(t *testing.T, data string) {
+		t.Helper()
+		for {
+			select {
+			case desc, ok := <-changes:
+				require.True(t, ok)
+				if string(desc.Data) == data {
+					return
+				}
+			case <-time.After(10 * time.Second):
+				t.Fatalf("no change notification with data %q", data)
+			}
+		}
+	}
+
+	require.NoError(t, s.UpdateSemaphore(ctx, semaphoreName, options.WithUpdateData([]byte("v2"))))
+	waitData(t, "v2")
+
+	s.Reconnect()
+
+	require.NoError(t, s.UpdateSemaphore(ctx, semaphoreName, options.WithUpdateData([]byte("v3"))))
+	waitData(t, "v3")
+
+	require.NoError(t, s.Close(ctx))
+	for range changes {
+	}
+}
@@ -0,0 +1,66 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func TestDatabaseSqlWithQueryService(t *testing.T) {
+	var (
+		ctx   = xtest.Context(t)
+		scope = newScope(t)
+		db    = scope.SQLDriverWithFolder(
+			ydb.WithQueryService(true),
+			ydb.WithTablePathPrefix(scope.Folder()),
+			ydb.WithAutoDeclare(),
+			ydb.WithNumericArgs(),
+		)
+	)
+
+	t.Run("create", func(t *testing.T) {
+		require.NoError(t, retry.Do(ydb.WithQueryMode(ctx, ydb.SchemeQueryMode), db,
+			func(ctx context.Context, cc *sql.Conn) error {
+				_, err := cc.ExecContext(ctx, "CREATE TABLE kv (id Int64, val Text, PRIMARY KEY (id))")
+
+				return err
+			}, retry.WithIdempotent(true),
+		))
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		require.NoError(t, retry.DoTx(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "UPSERT INTO kv (id, val) VALUES ($1, $2)", int64(1), "one")
+
+			return err
+		}, retry.WithIdempotent(true)))
+	})
+
+	t.Run("select", func(t *testing.T) {
+		var val string
+		require.NoError(t, retry.Do(ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+			return cc.QueryRowContext(ctx, "SELECT val FROM kv WHERE id = $1", int64(1)).Scan(&val)
+		}, retry.WithIdempotent(true)))
+		require.Equal(t, "one", val)
+	})
+
+	t.Run("snapshot read-only tx", func(t *testing.T) {
+		var cnt uint64
+		require.NoError(t, retry.DoTx(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+			return tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM kv").Scan(&cnt)
+		}, retry.WithIdempotent(true), retry.WithTxOptions(&sql.TxOptions{
+			Isolation: sql.LevelSnapshot,
+			ReadOnly:  true,
+		})))
+		require.EqualValues(t, 1, cnt)
+	})
+}
@@ -0,0 +1,73 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+func TestOperation(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
+		t.Skip("query service not allowed in YDB version '" + os.Getenv("YDB_VERSION") + "'")
+	}
+
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+
+	db, err := ydb.Open(ctx,
+		os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	scriptOperation, err := db.Query().ExecuteScript(ctx, `SELECT 1 AS a;`, time.Hour)
+	require.NoError(t, err)
+
+	op, err := db.Operation().Wait(ctx, scriptOperation.ID)
+	require.NoError(t, err)
+	require.True(t, op.Ready)
+	require.Equal(t, "SUCCESS", op.Status)
+
+	op, err = db.Operation().Get(ctx, scriptOperation.ID)
+	require.NoError(t, err)
+	require.True(t, op.Ready)
+	require.NoError(t, op.Err())
+
+	var (
+		found     bool
+		pageToken string
+	)
+	for {
+		res, err := db.Operation().List(ctx, operation.KindScriptExec,
+			operation.WithPageSize(10),
+			operation.WithPageToken(pageToken),
+		)
+		require.NoError(t, err)
+		for _, op := range res.Operations {
+			if op.ID == scriptOperation.ID {
+				found = true
+			}
+		}
+		if res.NextToken == "" {
+			break
+		}
+		pageToken = res.NextToken
+	}
+	require.True(t, found)
+
+	require.NoError(t, db.Operation().Forget(ctx, scriptOperation.ID))
+}
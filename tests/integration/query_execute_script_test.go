@@ -0,0 +1,84 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func TestQueryExecuteScript(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
+		t.Skip("query service not allowed in YDB version '" + os.Getenv("YDB_VERSION") + "'")
+	}
+
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+
+	db, err := ydb.Open(ctx,
+		os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	op, err := db.Query().ExecuteScript(ctx, `
+		SELECT CAST(1 AS Uint64) AS id
+		UNION ALL
+		SELECT CAST(2 AS Uint64) AS id
+		UNION ALL
+		SELECT CAST(3 AS Uint64) AS id;`,
+		time.Hour,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, op.ID)
+
+	for !op.Ready {
+		time.Sleep(100 * time.Millisecond)
+		op, err = db.Query().GetScriptOperation(ctx, op.ID)
+		require.NoError(t, err)
+	}
+	require.NotNil(t, op.Metadata)
+	require.Equal(t, query.ExecStatusCompleted, op.Metadata.Status)
+
+	var (
+		ids       []uint64
+		nextToken string
+	)
+	for {
+		result, err := db.Query().FetchScriptResults(ctx, op.ID,
+			query.WithResultSetIndex(0),
+			query.WithFetchToken(nextToken),
+			query.WithRowsLimit(2),
+		)
+		require.NoError(t, err)
+		for {
+			row, err := result.ResultSet.NextRow(ctx)
+			if err != nil {
+				break
+			}
+			var id uint64
+			require.NoError(t, row.Scan(&id))
+			ids = append(ids, id)
+		}
+		nextToken = result.NextToken
+		if nextToken == "" {
+			break
+		}
+	}
+	require.Equal(t, []uint64{1, 2, 3}, ids)
+
+	require.NoError(t, db.Query().ForgetScriptOperation(ctx, op.ID))
+}
@@ -93,6 +93,73 @@ func TestQueryExecute(t *testing.T) {
 		require.EqualValues(t, 100500000000, p2)
 		require.EqualValues(t, time.Duration(100500000000), p3)
 	})
+	t.Run("QueryStats", func(t *testing.T) {
+		var (
+			totalDuration time.Duration
+			phasesCount   int
+		)
+		err = db.Query().Do(ctx, func(ctx context.Context, s query.Session) (err error) {
+			_, res, err := s.Execute(ctx, `SELECT 42 AS id;`,
+				query.WithStatsMode(query.StatsModeFull),
+			)
+			if err != nil {
+				return err
+			}
+			for {
+				rs, err := res.NextResultSet(ctx)
+				if err != nil {
+					break
+				}
+				for {
+					if _, err = rs.NextRow(ctx); err != nil {
+						break
+					}
+				}
+			}
+			if err = res.Err(); err != nil {
+				return err
+			}
+			queryStats := res.Stats()
+			if queryStats == nil {
+				return fmt.Errorf("no query stats")
+			}
+			totalDuration = queryStats.TotalDuration()
+			phasesCount = len(queryStats.QueryPhases())
+
+			return nil
+		}, query.WithIdempotent())
+		require.NoError(t, err)
+		require.Positive(t, phasesCount)
+		require.Positive(t, totalDuration)
+	})
+	t.Run("ReadRow", func(t *testing.T) {
+		row, err := db.Query().ReadRow(ctx, `SELECT CAST(42 AS Uint64) AS id;`, query.WithIdempotent())
+		require.NoError(t, err)
+		var id uint64
+		require.NoError(t, row.Scan(&id))
+		require.EqualValues(t, 42, id)
+	})
+	t.Run("ReadResultSet", func(t *testing.T) {
+		rs, err := db.Query().ReadResultSet(ctx, `
+			SELECT CAST(1 AS Uint64) AS id
+			UNION ALL
+			SELECT CAST(2 AS Uint64) AS id;`,
+			query.WithIdempotent(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []string{"id"}, rs.Columns())
+		var ids []uint64
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				break
+			}
+			var id uint64
+			require.NoError(t, row.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.Equal(t, []uint64{1, 2}, ids)
+	})
 	t.Run("ScanNamed", func(t *testing.T) {
 		var (
 			p1 string
@@ -0,0 +1,62 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func TestQueryPoolMinIdle(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
+		t.Skip("query service not allowed in YDB version '" + os.Getenv("YDB_VERSION") + "'")
+	}
+
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+
+	db, err := ydb.Open(ctx,
+		os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+		ydb.WithSessionPoolSizeLimit(10),
+		ydb.WithSessionPoolMinIdle(3),
+		ydb.WithSessionPoolIdleThreshold(time.Second),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	xtest.SpinWaitCondition(t, nil, func() bool {
+		s, err := query.Stats(db.Query())
+		require.NoError(t, err)
+
+		return s.Idle == 3
+	})
+
+	s, err := query.Stats(db.Query())
+	require.NoError(t, err)
+	require.Equal(t, 3, s.MinIdle)
+	require.Equal(t, 3, s.Index)
+
+	var sessions int
+	for _, count := range s.Nodes {
+		sessions += count
+	}
+	require.Equal(t, s.Index, sessions)
+
+	err = db.Query().Do(ctx, func(ctx context.Context, s query.Session) error {
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,70 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func TestQueryPostgreSQLSyntax(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
+		t.Skip("PostgreSQL syntax of query service is not supported")
+	}
+
+	var (
+		ctx   = xtest.Context(t)
+		scope = newScope(t)
+		now   = time.Now().Truncate(time.Microsecond)
+	)
+
+	t.Run("query.Client", func(t *testing.T) {
+		parameters, err := query.PgArgs(int32(42), "test", now, (*int64)(nil))
+		require.NoError(t, err)
+		row, err := scope.Driver().Query().ReadRow(ctx,
+			`SELECT $1 AS a, $2 AS b, $3 AS c, $4 AS d`,
+			query.WithSyntax(query.SyntaxPostgreSQL),
+			query.WithParameters(parameters),
+		)
+		require.NoError(t, err)
+		var (
+			a int32
+			b string
+			c time.Time
+			d *int64
+		)
+		require.NoError(t, row.Scan(&a, &b, &c, &d))
+		require.EqualValues(t, 42, a)
+		require.Equal(t, "test", b)
+		require.True(t, now.Equal(c), c)
+		require.Nil(t, d)
+	})
+
+	t.Run("database/sql", func(t *testing.T) {
+		db := scope.SQLDriver(
+			ydb.WithQueryService(true),
+			ydb.WithPgArgs(),
+		)
+		var (
+			a int64
+			b string
+		)
+		require.NoError(t, retry.Do(ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+			return cc.QueryRowContext(ctx, `SELECT $1 + 1, $2 || '!'`, int64(1), "test").Scan(&a, &b)
+		}, retry.WithIdempotent(true)))
+		require.EqualValues(t, 2, a)
+		require.Equal(t, "test!", b)
+	})
+}
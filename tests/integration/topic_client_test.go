@@ -20,6 +20,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicsugar"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 )
@@ -159,6 +160,98 @@ func TestSchemeList(t *testing.T) {
 	require.True(t, hasTopic)
 }
 
+func TestDescribeTopicConsumer(t *testing.T) {
+	scope := newScope(t)
+	ctx := scope.Ctx
+
+	err := scope.TopicWriter().Write(ctx,
+		topicwriter.Message{Data: strings.NewReader("1")},
+		topicwriter.Message{Data: strings.NewReader("2")},
+	)
+	require.NoError(t, err)
+	require.NoError(t, scope.TopicWriter().Flush(ctx))
+
+	topicDescription, err := scope.Driver().Topic().Describe(ctx, scope.TopicPath(), topicoptions.IncludeStats)
+	require.NoError(t, err)
+	require.Len(t, topicDescription.Partitions, 1)
+	require.Equal(t, int64(2), topicDescription.Partitions[0].PartitionStats.PartitionsOffset.End)
+
+	consumerDescription, err := scope.Driver().Topic().DescribeTopicConsumer(
+		ctx,
+		scope.TopicPath(),
+		scope.TopicConsumerName(),
+		topicoptions.IncludeConsumerStats,
+	)
+	require.NoError(t, err)
+	require.Equal(t, scope.TopicConsumerName(), consumerDescription.Consumer.Name)
+	require.Len(t, consumerDescription.Partitions, 1)
+
+	partition := consumerDescription.Partitions[0]
+	require.Equal(t, int64(2), partition.PartitionStats.PartitionsOffset.End)
+	require.Equal(t, int64(0), partition.PartitionConsumerStats.CommittedOffset)
+	require.Equal(t, int64(2), partition.ReadLag())
+}
+
+func TestCommitOffset(t *testing.T) {
+	scope := newScope(t)
+	ctx := scope.Ctx
+
+	err := scope.TopicWriter().Write(ctx,
+		topicwriter.Message{Data: strings.NewReader("0")},
+		topicwriter.Message{Data: strings.NewReader("1")},
+		topicwriter.Message{Data: strings.NewReader("2")},
+	)
+	require.NoError(t, err)
+	require.NoError(t, scope.TopicWriter().Flush(ctx))
+
+	err = scope.Driver().Topic().CommitOffset(ctx, scope.TopicPath(), 0, scope.TopicConsumerName(), 2)
+	require.NoError(t, err)
+
+	msg, err := scope.TopicReader().ReadMessage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), msg.Offset)
+}
+
+func TestResetConsumerToTimestamp(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
+		t.Skip("Read topic without consumer implemented since YDB 24.1, test ran for '" + os.Getenv("YDB_VERSION") + "'")
+	}
+
+	scope := newScope(t)
+	ctx := scope.Ctx
+
+	err := scope.TopicWriter().Write(ctx, topicwriter.Message{Data: strings.NewReader("old")})
+	require.NoError(t, err)
+	require.NoError(t, scope.TopicWriter().Flush(ctx))
+
+	time.Sleep(time.Second)
+	timestamp := time.Now()
+	time.Sleep(time.Second)
+
+	err = scope.TopicWriter().Write(ctx, topicwriter.Message{Data: strings.NewReader("new")})
+	require.NoError(t, err)
+	require.NoError(t, scope.TopicWriter().Flush(ctx))
+
+	offsets, err := topicsugar.OffsetsForTimestamp(ctx, scope.Driver().Topic(), scope.TopicPath(), timestamp)
+	require.NoError(t, err)
+	require.Equal(t, map[int64]int64{0: 1}, offsets)
+
+	err = topicsugar.ResetConsumerToTimestamp(
+		ctx,
+		scope.Driver().Topic(),
+		scope.TopicPath(),
+		scope.TopicConsumerName(),
+		timestamp,
+	)
+	require.NoError(t, err)
+
+	msg, err := scope.TopicReader().ReadMessage(ctx)
+	require.NoError(t, err)
+	content, err := io.ReadAll(msg)
+	require.NoError(t, err)
+	require.Equal(t, "new", string(content))
+}
+
 func TestReaderWithoutConsumer(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
 		if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
@@ -231,6 +324,57 @@ func TestReaderWithoutConsumer(t *testing.T) {
 	})
 }
 
+func TestTopicAutoPartitioning(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.3") {
+		t.Skip("autopartitioning of topics not supported by server")
+	}
+
+	scope := newScope(t)
+	ctx := scope.Ctx
+	db := scope.Driver()
+	topicPath := scope.Folder() + "/autopartitioning-topic"
+
+	err := db.Topic().Create(ctx, topicPath,
+		topicoptions.CreateWithMinActivePartitions(1),
+		topicoptions.CreateWithMaxActivePartitions(10),
+		topicoptions.CreateWithAutoPartitioningStrategy(topictypes.AutoPartitioningStrategyScaleUp),
+		topicoptions.CreateWithAutoPartitioningStabilizationWindow(time.Minute),
+		topicoptions.CreateWithAutoPartitioningUpUtilizationPercent(80),
+	)
+	require.NoError(t, err)
+
+	description, err := db.Topic().Describe(ctx, topicPath)
+	require.NoError(t, err)
+
+	settings := description.PartitionSettings
+	require.Equal(t, int64(10), settings.MaxActivePartitions)
+	require.Equal(t,
+		topictypes.AutoPartitioningStrategyScaleUp,
+		settings.AutoPartitioningSettings.AutoPartitioningStrategy,
+	)
+	speed := settings.AutoPartitioningSettings.AutoPartitioningWriteSpeedStrategy
+	require.Equal(t, time.Minute, speed.StabilizationWindow)
+	require.Equal(t, int32(80), speed.UpUtilizationPercent)
+
+	err = db.Topic().Alter(ctx, topicPath,
+		topicoptions.AlterWithAutoPartitioningStrategy(topictypes.AutoPartitioningStrategyPaused),
+		topicoptions.AlterWithAutoPartitioningDownUtilizationPercent(20),
+	)
+	require.NoError(t, err)
+
+	description, err = db.Topic().Describe(ctx, topicPath)
+	require.NoError(t, err)
+
+	settings = description.PartitionSettings
+	require.Equal(t,
+		topictypes.AutoPartitioningStrategyPaused,
+		settings.AutoPartitioningSettings.AutoPartitioningStrategy,
+	)
+	speed = settings.AutoPartitioningSettings.AutoPartitioningWriteSpeedStrategy
+	require.Equal(t, int32(80), speed.UpUtilizationPercent)
+	require.Equal(t, int32(20), speed.DownUtilizationPercent)
+}
+
 func connect(t testing.TB, opts ...ydb.Option) *ydb.Driver {
 	return connectWithLogOption(t, false, opts...)
 }
@@ -0,0 +1,56 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTopicZstdCodec(t *testing.T) {
+	ctx := xtest.Context(t)
+	db := connect(t)
+	topicPath := db.Name() + "/topic-" + t.Name()
+
+	err := db.Topic().Drop(ctx, topicPath)
+	if err != nil {
+		require.True(t, ydb.IsOperationErrorSchemeError(err))
+	}
+
+	consumer := "test-consumer"
+	err = db.Topic().Create(ctx, topicPath,
+		topicoptions.CreateWithSupportedCodecs(topictypes.CodecRaw, topictypes.CodecZstd),
+		topicoptions.CreateWithConsumer(topictypes.Consumer{Name: consumer}),
+	)
+	require.NoError(t, err)
+
+	writer, err := db.Topic().StartWriter(topicPath, topicoptions.WithWriterCodec(topictypes.CodecZstd))
+	require.NoError(t, err)
+
+	data := strings.Repeat("zstd", 100)
+	err = writer.Write(ctx, topicwriter.Message{Data: strings.NewReader(data)})
+	require.NoError(t, err)
+	require.NoError(t, writer.Close(ctx))
+
+	reader, err := db.Topic().StartReader(consumer, topicoptions.ReadTopic(topicPath))
+	require.NoError(t, err)
+	defer func() {
+		_ = reader.Close(ctx)
+	}()
+
+	msg, err := reader.ReadMessage(ctx)
+	require.NoError(t, err)
+	content, err := io.ReadAll(msg)
+	require.NoError(t, err)
+	require.Equal(t, data, string(content))
+}
@@ -0,0 +1,85 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicsugar"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTopicDeadLetterProcessor(t *testing.T) {
+	scope := newScope(t)
+	ctx := scope.Ctx
+	db := scope.Driver()
+
+	deadLetterTopic := scope.Folder() + "/dead-letter"
+	deadLetterConsumer := "dead-letter-consumer"
+	err := db.Topic().Create(ctx, deadLetterTopic,
+		topicoptions.CreateWithConsumer(topictypes.Consumer{Name: deadLetterConsumer}),
+	)
+	require.NoError(t, err)
+
+	err = scope.TopicWriter().Write(ctx,
+		topicwriter.Message{Data: strings.NewReader("poison")},
+		topicwriter.Message{Data: strings.NewReader("good")},
+	)
+	require.NoError(t, err)
+
+	deadLetterWriter, err := db.Topic().StartWriter(deadLetterTopic)
+	require.NoError(t, err)
+	defer func() {
+		_ = deadLetterWriter.Close(ctx)
+	}()
+
+	var processed []string
+	processor := topicsugar.NewDeadLetterProcessor(
+		scope.TopicReader(),
+		deadLetterWriter,
+		func(ctx context.Context, msg *topicreader.Message) error {
+			content, err := io.ReadAll(msg)
+			if err != nil {
+				return err
+			}
+			if string(content) == "poison" {
+				return errors.New("poison message")
+			}
+			processed = append(processed, string(content))
+
+			return nil
+		},
+		topicsugar.WithDeadLetterMaxAttempts(2),
+	)
+
+	for i := 0; i < 2; i++ {
+		msg, err := scope.TopicReader().ReadMessage(ctx)
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessMessage(ctx, msg))
+	}
+	require.Equal(t, []string{"good"}, processed)
+
+	deadLetterReader, err := db.Topic().StartReader(deadLetterConsumer, topicoptions.ReadTopic(deadLetterTopic))
+	require.NoError(t, err)
+	defer func() {
+		_ = deadLetterReader.Close(ctx)
+	}()
+
+	msg, err := deadLetterReader.ReadMessage(ctx)
+	require.NoError(t, err)
+	content, err := io.ReadAll(msg)
+	require.NoError(t, err)
+	require.Equal(t, "poison", string(content))
+	require.Equal(t, "poison message", string(msg.Metadata[topicsugar.DeadLetterMetadataError]))
+	require.Equal(t, "2", string(msg.Metadata[topicsugar.DeadLetterMetadataAttempts]))
+}
@@ -0,0 +1,92 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topiclistener"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+type testTopicListenerHandler struct {
+	topiclistener.BaseHandler
+
+	readerCreated    atomic.Bool
+	partitionStarted atomic.Bool
+	messages         chan string
+}
+
+func (h *testTopicListenerHandler) OnReaderCreated(event *topiclistener.ReaderReady) error {
+	h.readerCreated.Store(event.Listener != nil)
+
+	return nil
+}
+
+func (h *testTopicListenerHandler) OnStartPartitionSession(
+	ctx context.Context,
+	event *topiclistener.EventStartPartitionSession,
+) error {
+	h.partitionStarted.Store(true)
+	event.Confirm()
+
+	return nil
+}
+
+func (h *testTopicListenerHandler) OnReadMessages(ctx context.Context, event *topiclistener.ReadMessages) error {
+	for _, msg := range event.Batch.Messages {
+		content, err := io.ReadAll(msg)
+		if err != nil {
+			return err
+		}
+		h.messages <- string(content)
+	}
+
+	return event.Commit(ctx)
+}
+
+func TestTopicListener(t *testing.T) {
+	var (
+		ctx   = xtest.Context(t)
+		scope = newScope(t)
+	)
+
+	err := scope.TopicWriter().Write(ctx, topicwriter.Message{Data: strings.NewReader("test")})
+	require.NoError(t, err)
+
+	handler := &testTopicListenerHandler{messages: make(chan string, 1)}
+	listener, err := scope.Driver().Topic().StartListener(
+		scope.TopicConsumerName(),
+		handler,
+		topicoptions.ReadTopic(scope.TopicPath()),
+	)
+	require.NoError(t, err)
+	require.NoError(t, listener.WaitInit(ctx))
+
+	select {
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	case content := <-handler.messages:
+		require.Equal(t, "test", content)
+	}
+	require.True(t, handler.readerCreated.Load())
+	require.True(t, handler.partitionStarted.Load())
+
+	stopped := make(empty.Chan)
+	go func() {
+		defer close(stopped)
+		_ = listener.WaitStop(ctx)
+	}()
+	require.NoError(t, listener.Close(ctx))
+	xtest.WaitChannelClosed(t, stopped)
+}
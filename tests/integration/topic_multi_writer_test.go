@@ -0,0 +1,90 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTopicMultiWriter(t *testing.T) {
+	ctx := xtest.Context(t)
+	db := connect(t)
+	topicPath := db.Name() + "/topic-" + t.Name()
+
+	err := db.Topic().Drop(ctx, topicPath)
+	if err != nil {
+		require.True(t, ydb.IsOperationErrorSchemeError(err))
+	}
+
+	consumer := "test-consumer"
+	err = db.Topic().Create(ctx, topicPath,
+		topicoptions.CreateWithMinActivePartitions(2),
+		topicoptions.CreateWithPartitionCountLimit(2),
+		topicoptions.CreateWithConsumer(topictypes.Consumer{Name: consumer}),
+	)
+	require.NoError(t, err)
+
+	writer, err := db.Topic().StartMultiWriter(topicPath)
+	require.NoError(t, err)
+
+	keys := []string{"a", "b", "c", "d"}
+	const messagesPerKey = 5
+	for i := 0; i < messagesPerKey; i++ {
+		for _, key := range keys {
+			err = writer.Write(ctx, topicwriter.MultiWriterMessage{
+				Key:           key,
+				PublicMessage: topicwriter.Message{Data: strings.NewReader(fmt.Sprintf("%s:%d", key, i))},
+			})
+			require.NoError(t, err)
+		}
+	}
+	err = writer.Write(ctx, topicwriter.MultiWriterMessage{
+		PublicMessage: topicwriter.Message{Data: strings.NewReader("explicit:0")},
+	}.WithPartitionID(1))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close(ctx))
+
+	reader, err := db.Topic().StartReader(consumer, topicoptions.ReadTopic(topicPath))
+	require.NoError(t, err)
+	defer func() {
+		_ = reader.Close(ctx)
+	}()
+
+	keyPartitions := make(map[string]int64)
+	keyNextIndex := make(map[string]int)
+	for i := 0; i < len(keys)*messagesPerKey+1; i++ {
+		msg, err := reader.ReadMessage(ctx)
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(msg)
+		require.NoError(t, err)
+
+		parts := strings.Split(string(content), ":")
+		key := parts[0]
+		index, err := strconv.Atoi(parts[1])
+		require.NoError(t, err)
+
+		if partitionID, ok := keyPartitions[key]; ok {
+			require.Equal(t, partitionID, msg.PartitionID(), "messages with same key must be in same partition")
+		}
+		keyPartitions[key] = msg.PartitionID()
+
+		require.Equal(t, keyNextIndex[key], index, "messages with same key must be in order of write")
+		keyNextIndex[key]++
+	}
+
+	require.Equal(t, int64(1), keyPartitions["explicit"])
+}
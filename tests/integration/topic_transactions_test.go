@@ -0,0 +1,98 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicsugar"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTopicTransactions(t *testing.T) {
+	if version.Lt(os.Getenv("YDB_VERSION"), "24.1") {
+		t.Skip("topic transactions are not supported")
+	}
+
+	var (
+		ctx   = xtest.Context(t)
+		scope = newScope(t)
+	)
+
+	t.Run("WriteCommitted", func(t *testing.T) {
+		err := scope.Driver().Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+			writer, err := scope.Driver().Topic().StartTransactionalWriter(tx, scope.TopicPath())
+			if err != nil {
+				return err
+			}
+
+			return writer.Write(ctx, topicwriter.Message{Data: strings.NewReader("committed")})
+		})
+		require.NoError(t, err)
+
+		msg, err := scope.TopicReader().ReadMessage(ctx)
+		require.NoError(t, err)
+		content, err := io.ReadAll(msg)
+		require.NoError(t, err)
+		require.Equal(t, "committed", string(content))
+		require.NoError(t, scope.TopicReader().Commit(ctx, msg))
+	})
+
+	t.Run("WriteRollbacked", func(t *testing.T) {
+		testErr := errors.New("test error")
+		err := scope.Driver().Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+			writer, err := scope.Driver().Topic().StartTransactionalWriter(tx, scope.TopicPath())
+			if err != nil {
+				return err
+			}
+			if err = writer.Write(ctx, topicwriter.Message{Data: strings.NewReader("rollbacked")}); err != nil {
+				return err
+			}
+
+			return testErr
+		})
+		require.ErrorIs(t, err, testErr)
+
+		readCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		_, err = scope.TopicReader().ReadMessage(readCtx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("ReadCommitted", func(t *testing.T) {
+		require.NoError(t, scope.TopicWriter().Write(ctx, topicwriter.Message{Data: strings.NewReader("read in tx")}))
+		require.NoError(t, scope.TopicWriter().Flush(ctx))
+
+		err := scope.Driver().Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+			batch, err := scope.TopicReader().PopMessagesBatchTx(ctx, tx, topicreader.WithBatchMaxCount(1))
+			if err != nil {
+				return err
+			}
+			var content string
+			if err = topicsugar.ReadMessageDataWithCallback(batch.Messages[0], func(data []byte) error {
+				content = string(data)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+			require.Equal(t, "read in tx", content)
+
+			return nil
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,36 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicsugar"
+)
+
+func TestTopicTypedWriterReader(t *testing.T) {
+	type testValue struct {
+		ID   int
+		Name string
+	}
+
+	scope := newScope(t)
+	ctx := scope.Ctx
+
+	writer := topicsugar.NewTypedWriter[testValue](scope.TopicWriter(), topicsugar.JSONSerializer[testValue]{})
+	err := writer.Write(ctx, testValue{ID: 1, Name: "first"}, testValue{ID: 2, Name: "second"})
+	require.NoError(t, err)
+	require.NoError(t, writer.Flush(ctx))
+
+	reader := topicsugar.NewTypedReader[testValue](scope.TopicReader(), topicsugar.JSONSerializer[testValue]{})
+	for _, expected := range []testValue{{ID: 1, Name: "first"}, {ID: 2, Name: "second"}} {
+		msg, err := reader.ReadMessage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, msg.Value)
+		require.Equal(t, topicsugar.ContentTypeJSON, string(msg.Metadata[topicsugar.ContentTypeMetadataKey]))
+		require.NoError(t, reader.Commit(ctx, msg))
+	}
+}
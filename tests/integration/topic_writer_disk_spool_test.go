@@ -0,0 +1,53 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTopicWriterDiskSpool(t *testing.T) {
+	scope := newScope(t)
+	ctx := scope.Ctx
+	spoolDir := t.TempDir()
+	const producerID = "disk-spool-producer"
+
+	writer, err := scope.Driver().Topic().StartWriter(
+		scope.TopicPath(),
+		topicoptions.WithWriterProducerID(producerID),
+		topicoptions.WithWriterDiskSpool(spoolDir),
+	)
+	require.NoError(t, err)
+
+	const messagesCount = 10
+	for i := 0; i < messagesCount; i++ {
+		err = writer.Write(ctx, topicwriter.Message{Data: strings.NewReader(strconv.Itoa(i))})
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close(ctx))
+
+	// all messages acked - spool is empty
+	entries, err := os.ReadDir(filepath.Join(spoolDir, producerID))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	reader := scope.TopicReader()
+	for i := 0; i < messagesCount; i++ {
+		msg, err := reader.ReadMessage(ctx)
+		require.NoError(t, err)
+		data, err := io.ReadAll(msg)
+		require.NoError(t, err)
+		require.Equal(t, strconv.Itoa(i), string(data))
+	}
+}
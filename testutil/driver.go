@@ -257,7 +257,8 @@ func NewBalancer(opts ...balancerOption) *balancerStub {
 	return c
 }
 
-func (b *balancerStub) OnUpdate(func(context.Context, []endpoint.Info)) {
+func (b *balancerStub) OnUpdate(func(context.Context, []endpoint.Info)) (unsubscribe func()) {
+	return func() {}
 }
 
 type clientConn struct {
@@ -3,6 +3,8 @@ package topic
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topiclistener"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
@@ -15,12 +17,29 @@ type Client interface {
 	// Alter change topic options
 	Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error
 
+	// CommitOffset commits offset of partition for the consumer without active reader.
+	// Offset is offset of next message which will be read by the consumer, it can be less than committed offset
+	// for re-read messages
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CommitOffset(ctx context.Context, path string, partitionID int64, consumer string, offset int64) error
+
 	// Create topic
 	Create(ctx context.Context, path string, opts ...topicoptions.CreateOption) error
 
 	// Describe topic
 	Describe(ctx context.Context, path string, opts ...topicoptions.DescribeOption) (topictypes.TopicDescription, error)
 
+	// DescribeTopicConsumer describes consumer of topic with read statistics of partitions
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	DescribeTopicConsumer(
+		ctx context.Context,
+		path string,
+		consumer string,
+		opts ...topicoptions.DescribeConsumerOption,
+	) (topictypes.TopicConsumerDescription, error)
+
 	// Drop topic
 	Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error
 
@@ -32,7 +51,36 @@ type Client interface {
 		opts ...topicoptions.ReaderOption,
 	) (*topicreader.Reader, error)
 
+	// StartListener start read messages from topic and push them to handler with partition sessions events
+	// it is fast non block call, connection starts in background
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StartListener(
+		consumer string,
+		handler topiclistener.EventHandler,
+		readSelectors topicoptions.ReadSelectors,
+		opts ...topicoptions.ReaderOption,
+	) (*topiclistener.TopicListener, error)
+
 	// StartWriter start write session to topic
 	// it is fast non block call, connection starts in background
 	StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error)
+
+	// StartTransactionalWriter start write session to topic inside the transaction
+	// messages become visible for readers only after commit of the transaction
+	// it is fast non block call, connection starts in background
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StartTransactionalWriter(
+		transaction query.TxActor,
+		topicPath string,
+		opts ...topicoptions.WriterOption,
+	) (*topicwriter.TxWriter, error)
+
+	// StartMultiWriter start writer to many partitions of the topic with route of messages
+	// by key or explicit partition id of message
+	// it is fast non block call, connections start on write
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StartMultiWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.MultiWriter, error)
 }
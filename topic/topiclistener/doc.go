@@ -0,0 +1,6 @@
+// Package topiclistener provide TopicListener to receive messages from YDB topics with callbacks.
+// Unlike topicreader.Reader the listener pushes read messages and partition sessions lifecycle events
+// to handler, which allow to load and flush state of partitions on start and stop of partition sessions.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package topiclistener
@@ -0,0 +1,81 @@
+package topiclistener
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+)
+
+// EventHandler is a handler of topic listener events
+//
+// OnStartPartitionSession and OnStopPartitionSession are called from internal goroutine of the listener
+// and must not block for long time. Start and stop of partition sessions can be confirmed asynchronously
+// with event.Confirm() after return from handler.
+// Server doesn't send messages of partition until start of partition session confirmed.
+//
+// OnReadMessages called sequentially for all partitions from read loop of the listener.
+//
+// Error from any handler stops the listener, the error will be returned from TopicListener.WaitStop.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type EventHandler interface {
+	// OnReaderCreated called once after create of the listener before any other events
+	OnReaderCreated(event *ReaderReady) error
+
+	// OnStartPartitionSession called when server starts send messages of partition to the listener
+	OnStartPartitionSession(ctx context.Context, event *EventStartPartitionSession) error
+
+	// OnStopPartitionSession called when server stops send messages of partition to the listener
+	OnStopPartitionSession(ctx context.Context, event *EventStopPartitionSession) error
+
+	// OnReadMessages called for each batch of read messages
+	OnReadMessages(ctx context.Context, event *ReadMessages) error
+}
+
+// BaseHandler implements EventHandler with immediately confirm of partition sessions events.
+// Embed it into own handler for override only needed methods, OnReadMessages must be implemented always
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type BaseHandler struct{}
+
+func (BaseHandler) OnReaderCreated(event *ReaderReady) error {
+	return nil
+}
+
+func (BaseHandler) OnStartPartitionSession(ctx context.Context, event *EventStartPartitionSession) error {
+	event.Confirm()
+
+	return nil
+}
+
+func (BaseHandler) OnStopPartitionSession(ctx context.Context, event *EventStopPartitionSession) error {
+	event.Confirm()
+
+	return nil
+}
+
+// ReaderReady is an event of create of the listener
+type ReaderReady struct {
+	Listener *TopicListener
+}
+
+type (
+	// PartitionSession is a session of read messages from one partition of topic
+	PartitionSession = topicreaderinternal.PublicPartitionSession
+
+	// OffsetsRange is a range of offsets [Start, End)
+	OffsetsRange = topicreaderinternal.PublicOffsetsRange
+
+	// EventStartPartitionSession is an event of start read from partition.
+	// Server doesn't send messages of the partition until the event confirmed
+	EventStartPartitionSession = topicreaderinternal.PublicEventStartPartitionSession
+
+	// StartPartitionSessionConfirm is parameters of confirm of start partition session with custom offsets
+	StartPartitionSessionConfirm = topicreaderinternal.PublicStartPartitionSessionConfirm
+
+	// EventStopPartitionSession is an event of stop read from partition
+	EventStopPartitionSession = topicreaderinternal.PublicEventStopPartitionSession
+
+	// ReadMessages is an event with batch of messages from one partition
+	ReadMessages = topicreaderinternal.PublicReadMessages
+)
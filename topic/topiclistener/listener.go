@@ -0,0 +1,45 @@
+package topiclistener
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+)
+
+// TopicListener reads messages from topic in background and pushes them to EventHandler
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TopicListener struct {
+	listener *topicreaderinternal.Listener
+}
+
+// NewTopicListener starts push events to handler of the listener. Used internally only.
+func NewTopicListener(listener *topicreaderinternal.Listener, handler EventHandler) (*TopicListener, error) {
+	res := &TopicListener{listener: listener}
+
+	if err := handler.OnReaderCreated(&ReaderReady{Listener: res}); err != nil {
+		_ = listener.Close(context.Background())
+
+		return nil, err
+	}
+
+	listener.Start()
+
+	return res, nil
+}
+
+// WaitInit waits until the listener is initialized
+// or an error occurs
+func (l *TopicListener) WaitInit(ctx context.Context) error {
+	return l.listener.WaitInit(ctx)
+}
+
+// WaitStop waits until the listener stopped by Close or by error of handler and returns reason of stop
+func (l *TopicListener) WaitStop(ctx context.Context) error {
+	return l.listener.WaitStop(ctx)
+}
+
+// Close stops the listener
+func (l *TopicListener) Close(ctx context.Context) error {
+	return l.listener.Close(ctx)
+}
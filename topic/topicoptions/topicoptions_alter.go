@@ -28,6 +28,39 @@ func AlterWithPartitionCountLimit(partitionCountLimit int64) AlterOption {
 	return withPartitionCountLimit(partitionCountLimit)
 }
 
+// AlterWithMaxActivePartitions change max active partitions of the topic
+func AlterWithMaxActivePartitions(maxActivePartitions int64) AlterOption {
+	return withMaxActivePartitions(maxActivePartitions)
+}
+
+// AlterWithAutoPartitioningStrategy change strategy of automatically split and merge partitions of the topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningStrategy(strategy topictypes.AutoPartitioningStrategy) AlterOption {
+	return withAutoPartitioningStrategy(strategy)
+}
+
+// AlterWithAutoPartitioningStabilizationWindow change time of stable load of partition before split or merge
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningStabilizationWindow(window time.Duration) AlterOption {
+	return withAutoPartitioningStabilizationWindow(window)
+}
+
+// AlterWithAutoPartitioningUpUtilizationPercent change utilization of partition write speed for split the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningUpUtilizationPercent(percent int32) AlterOption {
+	return withAutoPartitioningUpUtilizationPercent(percent)
+}
+
+// AlterWithAutoPartitioningDownUtilizationPercent change utilization of partition write speed for merge partitions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AlterWithAutoPartitioningDownUtilizationPercent(percent int32) AlterOption {
+	return withAutoPartitioningDownUtilizationPercent(percent)
+}
+
 // AlterWithRetentionPeriod change retention period of topic
 func AlterWithRetentionPeriod(retentionPeriod time.Duration) AlterOption {
 	return withRetentionPeriod(retentionPeriod)
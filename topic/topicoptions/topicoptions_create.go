@@ -28,6 +28,39 @@ func CreateWithPartitionCountLimit(count int64) CreateOption {
 	return withPartitionCountLimit(count)
 }
 
+// CreateWithMaxActivePartitions set max active partitions for the topic, used by autopartitioning
+func CreateWithMaxActivePartitions(count int64) CreateOption {
+	return withMaxActivePartitions(count)
+}
+
+// CreateWithAutoPartitioningStrategy set strategy of automatically split and merge partitions for the topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningStrategy(strategy topictypes.AutoPartitioningStrategy) CreateOption {
+	return withAutoPartitioningStrategy(strategy)
+}
+
+// CreateWithAutoPartitioningStabilizationWindow set time of stable load of partition before split or merge
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningStabilizationWindow(window time.Duration) CreateOption {
+	return withAutoPartitioningStabilizationWindow(window)
+}
+
+// CreateWithAutoPartitioningUpUtilizationPercent set utilization of partition write speed for split the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningUpUtilizationPercent(percent int32) CreateOption {
+	return withAutoPartitioningUpUtilizationPercent(percent)
+}
+
+// CreateWithAutoPartitioningDownUtilizationPercent set utilization of partition write speed for merge partitions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateWithAutoPartitioningDownUtilizationPercent(percent int32) CreateOption {
+	return withAutoPartitioningDownUtilizationPercent(percent)
+}
+
 // CreateWithRetentionPeriod set retention time interval for the topic.
 func CreateWithRetentionPeriod(retentionPeriod time.Duration) CreateOption {
 	return withRetentionPeriod(retentionPeriod)
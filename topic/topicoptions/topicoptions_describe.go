@@ -2,5 +2,18 @@ package topicoptions
 
 import "github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 
-// DescribeOption type for options of describe method. Not used now.
+// DescribeOption type for options of describe method.
 type DescribeOption func(req *rawtopic.DescribeTopicRequest)
+
+// IncludeStats additionally request statistics of topic and partitions
+func IncludeStats(req *rawtopic.DescribeTopicRequest) {
+	req.IncludeStats = true
+}
+
+// DescribeConsumerOption type for options of describe consumer method.
+type DescribeConsumerOption func(req *rawtopic.DescribeConsumerRequest)
+
+// IncludeConsumerStats additionally request statistics of partitions and read the partitions by the consumer
+func IncludeConsumerStats(req *rawtopic.DescribeConsumerRequest) {
+	req.IncludeStats = true
+}
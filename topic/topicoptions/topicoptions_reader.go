@@ -90,6 +90,19 @@ func WithReaderCommitTimeLagTrigger(lag time.Duration) ReaderOption {
 	}
 }
 
+// WithReaderSupportSplitMergePartitions set support of autopartitioning (split and merge of partitions) for reader.
+// With the support server sends end of partition session after last message of split or merged partition
+// and starts read of child partitions after commit of all messages of the parent partition,
+// so the support requires commits of messages (reader without consumer and commits can't use it).
+// Default value: false
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderSupportSplitMergePartitions(enableSupport bool) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.AutoPartitioningSupport = enableSupport
+	}
+}
+
 // WithCommitCountTrigger
 //
 // Deprecated: was experimental and not actual now.
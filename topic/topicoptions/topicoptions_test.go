@@ -5,6 +5,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 func TestEqualAlterOptions(t *testing.T) {
@@ -98,3 +103,59 @@ func TestEqualCreateOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoPartitioningOptions(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		req := &rawtopic.CreateTopicRequest{}
+		for _, opt := range []CreateOption{
+			CreateWithMaxActivePartitions(10),
+			CreateWithAutoPartitioningStrategy(topictypes.AutoPartitioningStrategyScaleUp),
+			CreateWithAutoPartitioningStabilizationWindow(time.Minute),
+			CreateWithAutoPartitioningUpUtilizationPercent(80),
+			CreateWithAutoPartitioningDownUtilizationPercent(20),
+		} {
+			opt.ApplyCreateOption(req)
+		}
+
+		settings := req.ToProto().GetPartitioningSettings()
+		require.Equal(t, int64(10), settings.GetMaxActivePartitions())
+		require.Equal(t,
+			Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_SCALE_UP,
+			settings.GetAutoPartitioningSettings().GetStrategy(),
+		)
+		speed := settings.GetAutoPartitioningSettings().GetPartitionWriteSpeed()
+		require.Equal(t, time.Minute, speed.GetStabilizationWindow().AsDuration())
+		require.Equal(t, int32(80), speed.GetUpUtilizationPercent())
+		require.Equal(t, int32(20), speed.GetDownUtilizationPercent())
+	})
+	t.Run("CreateWithoutAutoPartitioning", func(t *testing.T) {
+		req := &rawtopic.CreateTopicRequest{}
+		CreateWithMinActivePartitions(2).ApplyCreateOption(req)
+
+		require.Nil(t, req.ToProto().GetPartitioningSettings().GetAutoPartitioningSettings())
+	})
+	t.Run("Alter", func(t *testing.T) {
+		req := &rawtopic.AlterTopicRequest{}
+		for _, opt := range []AlterOption{
+			AlterWithMaxActivePartitions(10),
+			AlterWithAutoPartitioningStrategy(topictypes.AutoPartitioningStrategyPaused),
+			AlterWithAutoPartitioningUpUtilizationPercent(70),
+		} {
+			opt.ApplyAlterOption(req)
+		}
+
+		settings := req.ToProto().GetAlterPartitioningSettings()
+		require.Equal(t, int64(10), settings.GetSetMaxActivePartitions())
+		require.Nil(t, settings.SetMinActivePartitions)
+
+		autoPartitioning := settings.GetAlterAutoPartitioningSettings()
+		require.Equal(t,
+			Ydb_Topic.AutoPartitioningStrategy_AUTO_PARTITIONING_STRATEGY_PAUSED,
+			autoPartitioning.GetSetStrategy(),
+		)
+		speed := autoPartitioning.GetSetPartitionWriteSpeed()
+		require.Equal(t, int32(70), speed.GetSetUpUtilizationPercent())
+		require.Nil(t, speed.SetDownUtilizationPercent)
+		require.Nil(t, speed.SetStabilizationWindow)
+	})
+}
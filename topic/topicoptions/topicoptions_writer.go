@@ -208,3 +208,16 @@ func WithWriterTrace(t trace.Topic) WriterOption { //nolint:gocritic
 func WithWriterUpdateTokenInterval(interval time.Duration) WriterOption {
 	return topicwriterinternal.WithTokenUpdateInterval(interval)
 }
+
+// WithWriterDiskSpool enable write-ahead log of messages in the dir.
+// Every written message is stored to local disk before send to server and removed after ack from server.
+// Messages, which were not acked before restart of the application, are sent again by new writer
+// with the same producer id, server deduplicates them by SeqNo.
+// Spool of the writer is stored in subdirectory of the dir with name of producer id,
+// producer id must be set explicitly by WithWriterProducerID.
+// Supported by simple writer only, transactional writer and multi writer return error.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWriterDiskSpool(dir string) WriterOption {
+	return topicwriterinternal.WithDiskSpool(dir)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"sync/atomic"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 )
 
 // Reader allow to read message from YDB topics.
@@ -102,6 +104,32 @@ func (r *Reader) ReadMessagesBatch(ctx context.Context, opts ...ReadBatchOption)
 	return r.reader.ReadMessageBatch(ctx, opts...)
 }
 
+// PopMessagesBatchTx read batch of messages and commit its offsets inside the transaction.
+// Offsets become committed only on commit of the transaction.
+// If the transaction rollbacked - the reader reconnects and messages will be read again.
+// Lazy transaction begins before read if it is not begun yet.
+//
+// The method must not be called concurrently with other methods of the transaction.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) PopMessagesBatchTx(
+	ctx context.Context,
+	transaction query.TxActor,
+	opts ...ReadBatchOption,
+) (*Batch, error) {
+	if err := r.inCall(&r.readInFlyght); err != nil {
+		return nil, err
+	}
+	defer r.outCall(&r.readInFlyght)
+
+	internalTx, err := tx.AsTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.reader.PopMessagesBatchTx(ctx, internalTx, opts...)
+}
+
 // Batch is ordered group of messages from one partition
 type Batch = topicreaderinternal.PublicBatch
 
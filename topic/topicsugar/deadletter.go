@@ -0,0 +1,243 @@
+package topicsugar
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+// Metadata keys of messages, written to dead-letter topic
+const (
+	DeadLetterMetadataError     = "ydb-dead-letter-error"
+	DeadLetterMetadataTopic     = "ydb-dead-letter-topic"
+	DeadLetterMetadataPartition = "ydb-dead-letter-partition"
+	DeadLetterMetadataOffset    = "ydb-dead-letter-offset"
+	DeadLetterMetadataAttempts  = "ydb-dead-letter-attempts"
+)
+
+const defaultDeadLetterMaxAttempts = 3
+
+// DeadLetterMessageHandler process message of the topic, error means failed processing of the message.
+// The handler receives copy of message content for every attempt and must not commit the message.
+type DeadLetterMessageHandler func(ctx context.Context, msg *topicreader.Message) error
+
+// DeadLetterOption is option of DeadLetterProcessor
+type DeadLetterOption func(p *DeadLetterProcessor)
+
+// WithDeadLetterMaxAttempts set count of handler attempts before write message to dead-letter topic
+// Default value: 3
+func WithDeadLetterMaxAttempts(maxAttempts int) DeadLetterOption {
+	return func(p *DeadLetterProcessor) {
+		p.maxAttempts = maxAttempts
+	}
+}
+
+// WithDeadLetterBackoff set backoff between handler attempts, see retry.Backoff
+func WithDeadLetterBackoff(b backoff.Backoff) DeadLetterOption {
+	return func(p *DeadLetterProcessor) {
+		p.backoff = b
+	}
+}
+
+type deadLetterReader interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+type deadLetterWriter interface {
+	Write(ctx context.Context, messages ...topicwriter.Message) error
+	Flush(ctx context.Context) error
+}
+
+// DeadLetterProcessor reads messages from the reader and process them by handler with retries.
+// Message, failed all attempts, is written to dead-letter topic with error description in Metadata,
+// and commits after acknowledge of the dead-letter message from server. Poison message doesn't block
+// the partition and doesn't lost.
+//
+// Message can be written to dead-letter topic twice if process was interrupted between write and commit,
+// use TxDeadLetterProcessor for atomic write to dead-letter topic and commit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DeadLetterProcessor struct {
+	reader           deadLetterReader
+	deadLetterWriter deadLetterWriter
+	handler          DeadLetterMessageHandler
+	maxAttempts      int
+	backoff          backoff.Backoff
+}
+
+// NewDeadLetterProcessor create processor of messages from the reader with write failed messages
+// to dead-letter topic by the writer
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewDeadLetterProcessor(
+	reader *topicreader.Reader,
+	deadLetterWriter *topicwriter.Writer,
+	handler DeadLetterMessageHandler,
+	opts ...DeadLetterOption,
+) *DeadLetterProcessor {
+	return newDeadLetterProcessor(reader, deadLetterWriter, handler, opts...)
+}
+
+func newDeadLetterProcessor(
+	reader deadLetterReader,
+	deadLetterWriter deadLetterWriter,
+	handler DeadLetterMessageHandler,
+	opts ...DeadLetterOption,
+) *DeadLetterProcessor {
+	p := &DeadLetterProcessor{
+		reader:           reader,
+		deadLetterWriter: deadLetterWriter,
+		handler:          handler,
+		maxAttempts:      defaultDeadLetterMaxAttempts,
+		backoff:          backoff.Fast,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(p)
+		}
+	}
+	if p.maxAttempts < 1 {
+		p.maxAttempts = 1
+	}
+
+	return p
+}
+
+// Run reads and process messages until error of read, write to dead-letter topic or commit
+func (p *DeadLetterProcessor) Run(ctx context.Context) error {
+	for {
+		msg, err := p.reader.ReadMessage(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = p.ProcessMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+}
+
+// ProcessMessage process the message by handler with retries, write it to dead-letter topic if all attempts failed
+// and commit the message.
+// The message must be received from reader of the processor and must not be read before.
+func (p *DeadLetterProcessor) ProcessMessage(ctx context.Context, msg *topicreader.Message) error {
+	deadLetter, err := p.process(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if deadLetter != nil {
+		return p.sendToDeadLetter(ctx, msg, deadLetter)
+	}
+
+	return p.reader.Commit(ctx, msg)
+}
+
+// process process the message by handler with retries, it returns message for dead-letter topic
+// if all attempts failed and nil if the message processed successfully
+func (p *DeadLetterProcessor) process(ctx context.Context, msg *topicreader.Message) (*topicwriter.Message, error) {
+	data, err := io.ReadAll(msg)
+	if err != nil {
+		// content of the message can't be read, for example with unknown codec, retries are useless
+		return deadLetterMessage(msg, nil, 0, err), nil
+	}
+
+	var handlerErr error
+	for attempt := 0; attempt < p.maxAttempts; attempt++ {
+		if attempt > 0 {
+			if err = p.wait(ctx, attempt-1); err != nil {
+				return nil, err
+			}
+		}
+
+		handlerErr = p.handler(ctx, attemptMessage(msg, data))
+		if handlerErr == nil {
+			return nil, nil //nolint:nilnil
+		}
+	}
+
+	return deadLetterMessage(msg, data, p.maxAttempts, handlerErr), nil
+}
+
+func (p *DeadLetterProcessor) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.backoff.Delay(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (p *DeadLetterProcessor) sendToDeadLetter(
+	ctx context.Context,
+	msg *topicreader.Message,
+	deadLetter *topicwriter.Message,
+) error {
+	err := p.deadLetterWriter.Write(ctx, *deadLetter)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed write message to dead-letter topic: %w", err))
+	}
+
+	// commit after the dead-letter message saved on server only for prevent lost of the message
+	if err = p.deadLetterWriter.Flush(ctx); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed flush message to dead-letter topic: %w", err))
+	}
+
+	return p.reader.Commit(ctx, msg)
+}
+
+// deadLetterMessage make message for dead-letter topic with description of failure in metadata
+func deadLetterMessage(
+	msg *topicreader.Message,
+	data []byte,
+	attempts int,
+	reason error,
+) *topicwriter.Message {
+	metadata := make(map[string][]byte, len(msg.Metadata)+5) //nolint:gomnd
+	for key, val := range msg.Metadata {
+		metadata[key] = val
+	}
+	metadata[DeadLetterMetadataError] = []byte(reason.Error())
+	metadata[DeadLetterMetadataTopic] = []byte(msg.Topic())
+	metadata[DeadLetterMetadataPartition] = []byte(strconv.FormatInt(msg.PartitionID(), 10))
+	metadata[DeadLetterMetadataOffset] = []byte(strconv.FormatInt(msg.Offset, 10))
+	metadata[DeadLetterMetadataAttempts] = []byte(strconv.Itoa(attempts))
+
+	return &topicwriter.Message{
+		Data:     bytes.NewReader(data),
+		Metadata: metadata,
+	}
+}
+
+// attemptMessage create copy of the message with own content reader for handler attempt
+func attemptMessage(msg *topicreader.Message, data []byte) *topicreader.Message {
+	builder := topicreaderinternal.NewPublicMessageBuilder().
+		Seqno(msg.SeqNo).
+		CreatedAt(msg.CreatedAt).
+		MessageGroupID(msg.MessageGroupID).
+		WriteSessionMetadata(msg.WriteSessionMetadata).
+		Offset(msg.Offset).
+		WrittenAt(msg.WrittenAt).
+		ProducerID(msg.ProducerID).
+		DataAndUncompressedSize(data)
+	if msg.Metadata != nil {
+		builder.Metadata(msg.Metadata)
+	}
+	builder.Context(msg.Context())
+	builder.Topic(msg.Topic())
+	builder.PartitionID(msg.PartitionID())
+
+	return builder.Build()
+}
@@ -0,0 +1,167 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+type testDeadLetterReader struct {
+	messages  []*topicreader.Message
+	committed []topicreader.CommitRangeGetter
+}
+
+func (r *testDeadLetterReader) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	if len(r.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+
+	return msg, nil
+}
+
+func (r *testDeadLetterReader) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.committed = append(r.committed, obj)
+
+	return nil
+}
+
+type testDeadLetterMessage struct {
+	data     string
+	metadata map[string][]byte
+}
+
+type testDeadLetterWriter struct {
+	written  []testDeadLetterMessage
+	flushed  int
+	writeErr error
+}
+
+func (w *testDeadLetterWriter) Write(ctx context.Context, messages ...topicwriter.Message) error {
+	if w.writeErr != nil {
+		return w.writeErr
+	}
+	for i := range messages {
+		data, err := io.ReadAll(messages[i].Data)
+		if err != nil {
+			return err
+		}
+		w.written = append(w.written, testDeadLetterMessage{data: string(data), metadata: messages[i].Metadata})
+	}
+
+	return nil
+}
+
+func (w *testDeadLetterWriter) Flush(ctx context.Context) error {
+	w.flushed++
+
+	return nil
+}
+
+func newTestDeadLetterMessage(data string) *topicreader.Message {
+	builder := topicreaderinternal.NewPublicMessageBuilder().
+		Offset(10).
+		Metadata(map[string][]byte{"key": []byte("value")}).
+		DataAndUncompressedSize([]byte(data))
+	builder.Topic("/topic")
+	builder.PartitionID(2)
+
+	return builder.Build()
+}
+
+func TestDeadLetterProcessor(t *testing.T) {
+	noBackoff := WithDeadLetterBackoff(retry.Backoff(time.Nanosecond, 0, 0))
+
+	t.Run("SuccessAfterRetry", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		reader := &testDeadLetterReader{}
+		writer := &testDeadLetterWriter{}
+
+		var contents []string
+		p := newDeadLetterProcessor(reader, writer, func(ctx context.Context, msg *topicreader.Message) error {
+			data, err := io.ReadAll(msg)
+			require.NoError(t, err)
+			contents = append(contents, string(data))
+			if len(contents) < 2 {
+				return errors.New("test")
+			}
+
+			return nil
+		}, noBackoff)
+
+		msg := newTestDeadLetterMessage("test-data")
+		require.NoError(t, p.ProcessMessage(ctx, msg))
+		require.Equal(t, []string{"test-data", "test-data"}, contents)
+		require.Equal(t, []topicreader.CommitRangeGetter{msg}, reader.committed)
+		require.Empty(t, writer.written)
+	})
+	t.Run("DeadLetterAfterMaxAttempts", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		reader := &testDeadLetterReader{}
+		writer := &testDeadLetterWriter{}
+
+		attempts := 0
+		p := newDeadLetterProcessor(reader, writer, func(ctx context.Context, msg *topicreader.Message) error {
+			attempts++
+
+			return errors.New("test error")
+		}, noBackoff, WithDeadLetterMaxAttempts(5))
+
+		msg := newTestDeadLetterMessage("poison")
+		require.NoError(t, p.ProcessMessage(ctx, msg))
+		require.Equal(t, 5, attempts)
+		require.Equal(t, 1, writer.flushed)
+		require.Equal(t, []topicreader.CommitRangeGetter{msg}, reader.committed)
+		require.Equal(t, []testDeadLetterMessage{{
+			data: "poison",
+			metadata: map[string][]byte{
+				"key":                       []byte("value"),
+				DeadLetterMetadataError:     []byte("test error"),
+				DeadLetterMetadataTopic:     []byte("/topic"),
+				DeadLetterMetadataPartition: []byte("2"),
+				DeadLetterMetadataOffset:    []byte("10"),
+				DeadLetterMetadataAttempts:  []byte("5"),
+			},
+		}}, writer.written)
+	})
+	t.Run("NoCommitOnDeadLetterError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		reader := &testDeadLetterReader{}
+		testErr := errors.New("write error")
+		writer := &testDeadLetterWriter{writeErr: testErr}
+
+		p := newDeadLetterProcessor(reader, writer, func(ctx context.Context, msg *topicreader.Message) error {
+			return errors.New("test error")
+		}, noBackoff)
+
+		err := p.ProcessMessage(ctx, newTestDeadLetterMessage("poison"))
+		require.ErrorIs(t, err, testErr)
+		require.Empty(t, reader.committed)
+	})
+	t.Run("RunUntilReadError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		reader := &testDeadLetterReader{messages: []*topicreader.Message{
+			newTestDeadLetterMessage("1"),
+			newTestDeadLetterMessage("2"),
+		}}
+		writer := &testDeadLetterWriter{}
+
+		p := newDeadLetterProcessor(reader, writer, func(ctx context.Context, msg *topicreader.Message) error {
+			return nil
+		})
+
+		require.ErrorIs(t, p.Run(ctx), io.EOF)
+		require.Len(t, reader.committed, 2)
+	})
+}
@@ -0,0 +1,118 @@
+package topicsugar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+type deadLetterTxReader interface {
+	PopMessagesBatchTx(
+		ctx context.Context,
+		transaction query.TxActor,
+		opts ...topicreader.ReadBatchOption,
+	) (*topicreader.Batch, error)
+}
+
+type deadLetterTxWriter interface {
+	Write(ctx context.Context, messages ...topicwriter.Message) error
+}
+
+// TxDeadLetterProcessor reads batches of messages inside transactions and process them by handler with retries.
+// Messages, failed all attempts, are written to dead-letter topic by transactional writer, so write to
+// dead-letter topic and commit of original offsets are atomic: dead-letter messages become visible
+// only with commit of the batch and a message can't be written to dead-letter topic twice.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TxDeadLetterProcessor struct {
+	processor   *DeadLetterProcessor
+	db          query.Client
+	reader      deadLetterTxReader
+	startWriter func(transaction query.TxActor) (deadLetterTxWriter, error)
+}
+
+// NewTxDeadLetterProcessor create processor of messages from the reader with transactional write of failed
+// messages to dead-letter topic by path deadLetterTopicPath. Transactions are executed by the query client,
+// writers of dead-letter topic are started by the topic client.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewTxDeadLetterProcessor(
+	db query.Client,
+	topicClient topic.Client,
+	reader *topicreader.Reader,
+	deadLetterTopicPath string,
+	handler DeadLetterMessageHandler,
+	opts ...DeadLetterOption,
+) *TxDeadLetterProcessor {
+	return newTxDeadLetterProcessor(db, reader, func(transaction query.TxActor) (deadLetterTxWriter, error) {
+		return topicClient.StartTransactionalWriter(transaction, deadLetterTopicPath)
+	}, handler, opts...)
+}
+
+func newTxDeadLetterProcessor(
+	db query.Client,
+	reader deadLetterTxReader,
+	startWriter func(transaction query.TxActor) (deadLetterTxWriter, error),
+	handler DeadLetterMessageHandler,
+	opts ...DeadLetterOption,
+) *TxDeadLetterProcessor {
+	return &TxDeadLetterProcessor{
+		processor:   newDeadLetterProcessor(nil, nil, handler, opts...),
+		db:          db,
+		reader:      reader,
+		startWriter: startWriter,
+	}
+}
+
+// Run reads and process batches of messages until error of read, write to dead-letter topic or transaction
+func (p *TxDeadLetterProcessor) Run(ctx context.Context) error {
+	for {
+		if err := p.ProcessBatch(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// ProcessBatch reads batch of messages inside transaction, process messages by handler with retries
+// and writes failed messages to dead-letter topic inside the same transaction.
+// Handler can be called again for messages of the batch if the transaction is retried.
+func (p *TxDeadLetterProcessor) ProcessBatch(ctx context.Context, opts ...topicreader.ReadBatchOption) error {
+	return p.db.DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		batch, err := p.reader.PopMessagesBatchTx(ctx, tx, opts...)
+		if err != nil {
+			return err
+		}
+
+		var deadLetters []topicwriter.Message
+		for _, msg := range batch.Messages {
+			deadLetter, err := p.processor.process(ctx, msg)
+			if err != nil {
+				return err
+			}
+			if deadLetter != nil {
+				deadLetters = append(deadLetters, *deadLetter)
+			}
+		}
+
+		if len(deadLetters) == 0 {
+			return nil
+		}
+
+		writer, err := p.startWriter(tx)
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("ydb: failed start writer to dead-letter topic: %w", err))
+		}
+
+		// messages are flushed before commit of the transaction
+		if err = writer.Write(ctx, deadLetters...); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("ydb: failed write message to dead-letter topic: %w", err))
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,118 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// testTxClient calls operation once, transaction is committed if operation returns nil
+type testTxClient struct {
+	query.Client
+
+	committed int
+}
+
+func (c *testTxClient) DoTx(ctx context.Context, op query.TxOperation, opts ...options.DoTxOption) error {
+	if err := op(ctx, nil); err != nil {
+		return err
+	}
+	c.committed++
+
+	return nil
+}
+
+type testDeadLetterTxReader struct {
+	batch *topicreader.Batch
+}
+
+func (r *testDeadLetterTxReader) PopMessagesBatchTx(
+	ctx context.Context,
+	transaction query.TxActor,
+	opts ...topicreader.ReadBatchOption,
+) (*topicreader.Batch, error) {
+	return r.batch, nil
+}
+
+func TestTxDeadLetterProcessor(t *testing.T) {
+	noBackoff := WithDeadLetterBackoff(retry.Backoff(time.Nanosecond, 0, 0))
+
+	t.Run("DeadLetterInTransaction", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		db := &testTxClient{}
+		reader := &testDeadLetterTxReader{batch: &topicreader.Batch{Messages: []*topicreader.Message{
+			newTestDeadLetterMessage("ok"),
+			newTestDeadLetterMessage("poison"),
+		}}}
+		writer := &testDeadLetterWriter{}
+		writersStarted := 0
+
+		p := newTxDeadLetterProcessor(db, reader, func(transaction query.TxActor) (deadLetterTxWriter, error) {
+			writersStarted++
+
+			return writer, nil
+		}, func(ctx context.Context, msg *topicreader.Message) error {
+			data, err := io.ReadAll(msg)
+			require.NoError(t, err)
+			if string(data) == "poison" {
+				return errors.New("test error")
+			}
+
+			return nil
+		}, noBackoff, WithDeadLetterMaxAttempts(2))
+
+		require.NoError(t, p.ProcessBatch(ctx))
+		require.Equal(t, 1, db.committed)
+		require.Equal(t, 1, writersStarted)
+		require.Len(t, writer.written, 1)
+		require.Equal(t, "poison", writer.written[0].data)
+		require.Equal(t, []byte("2"), writer.written[0].metadata[DeadLetterMetadataAttempts])
+		// transactional writer flushes messages on commit of transaction
+		require.Zero(t, writer.flushed)
+	})
+	t.Run("NoWriterWithoutFailures", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		db := &testTxClient{}
+		reader := &testDeadLetterTxReader{batch: &topicreader.Batch{Messages: []*topicreader.Message{
+			newTestDeadLetterMessage("ok"),
+		}}}
+
+		p := newTxDeadLetterProcessor(db, reader, func(transaction query.TxActor) (deadLetterTxWriter, error) {
+			t.Fatal("writer must not be started")
+
+			return nil, nil //nolint:nilnil
+		}, func(ctx context.Context, msg *topicreader.Message) error {
+			return nil
+		})
+
+		require.NoError(t, p.ProcessBatch(ctx))
+		require.Equal(t, 1, db.committed)
+	})
+	t.Run("NoCommitOnDeadLetterError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		db := &testTxClient{}
+		reader := &testDeadLetterTxReader{batch: &topicreader.Batch{Messages: []*topicreader.Message{
+			newTestDeadLetterMessage("poison"),
+		}}}
+		testErr := errors.New("write error")
+
+		p := newTxDeadLetterProcessor(db, reader, func(transaction query.TxActor) (deadLetterTxWriter, error) {
+			return &testDeadLetterWriter{writeErr: testErr}, nil
+		}, func(ctx context.Context, msg *topicreader.Message) error {
+			return errors.New("test error")
+		}, noBackoff)
+
+		require.ErrorIs(t, p.ProcessBatch(ctx), testErr)
+		require.Zero(t, db.committed)
+	})
+}
@@ -0,0 +1,101 @@
+package topicsugar
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+// OffsetsForTimestamp resolves offset of first message written at or after timestamp for every partition of topic.
+// If partition has no messages written after the timestamp - offset is end offset of the partition.
+// Resolve uses read without consumer for partitions with messages after timestamp.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func OffsetsForTimestamp(
+	ctx context.Context,
+	client topic.Client,
+	topicPath string,
+	timestamp time.Time,
+) (map[int64]int64, error) {
+	description, err := client.Describe(ctx, topicPath, topicoptions.IncludeStats)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make(map[int64]int64, len(description.Partitions))
+	for i := range description.Partitions {
+		partition := &description.Partitions[i]
+		offset, err := partitionOffsetForTimestamp(ctx, client, topicPath, partition, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		offsets[partition.PartitionID] = offset
+	}
+
+	return offsets, nil
+}
+
+// ResetConsumerToTimestamp commits offsets of all partitions of topic for the consumer for start read
+// from first message written at or after timestamp.
+// Active readers of the consumer must be stopped before reset, else they can commit own offsets after the reset.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ResetConsumerToTimestamp(
+	ctx context.Context,
+	client topic.Client,
+	topicPath string,
+	consumer string,
+	timestamp time.Time,
+) error {
+	offsets, err := OffsetsForTimestamp(ctx, client, topicPath, timestamp)
+	if err != nil {
+		return err
+	}
+
+	for partitionID, offset := range offsets {
+		if err = client.CommitOffset(ctx, topicPath, partitionID, consumer, offset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func partitionOffsetForTimestamp(
+	ctx context.Context,
+	client topic.Client,
+	topicPath string,
+	partition *topictypes.PartitionInfo,
+	timestamp time.Time,
+) (_ int64, resErr error) {
+	stats := &partition.PartitionStats
+	if stats.LastWriteTime.IsZero() || stats.LastWriteTime.Before(timestamp) {
+		return stats.PartitionsOffset.End, nil
+	}
+
+	reader, err := client.StartReader("", topicoptions.ReadSelectors{
+		{
+			Path:       topicPath,
+			Partitions: []int64{partition.PartitionID},
+			ReadFrom:   timestamp,
+		},
+	}, topicoptions.WithReaderWithoutConsumer(false))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := reader.Close(ctx); resErr == nil {
+			resErr = closeErr
+		}
+	}()
+
+	msg, err := reader.ReadMessage(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return msg.Offset, nil
+}
@@ -0,0 +1,94 @@
+package topicsugar
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Content types of built-in serializers
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeGob      = "application/x-gob"
+)
+
+// Serializer convert values of type T to content of topic messages and back
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Serializer[T any] interface {
+	// ContentType of serialized values, it stamps to metadata of written messages
+	ContentType() string
+
+	// Marshal serialize value to message content
+	Marshal(v T) ([]byte, error)
+
+	// Unmarshal deserialize message content to dst.
+	// Unmarshal MUST NOT use data after return, copy it if need.
+	Unmarshal(data []byte, dst *T) error
+}
+
+// JSONSerializer serialize values with encoding/json
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type JSONSerializer[T any] struct{}
+
+func (JSONSerializer[T]) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (JSONSerializer[T]) Marshal(v T) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONSerializer[T]) Unmarshal(data []byte, dst *T) error {
+	return json.Unmarshal(data, dst)
+}
+
+// ProtoSerializer serialize protobuf messages, T must be pointer to generated protobuf struct
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ProtoSerializer[T proto.Message] struct{}
+
+func (ProtoSerializer[T]) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (ProtoSerializer[T]) Marshal(v T) ([]byte, error) {
+	return proto.Marshal(v)
+}
+
+func (ProtoSerializer[T]) Unmarshal(data []byte, dst *T) error {
+	// create new message of type T, because *dst can be nil pointer
+	msg, _ := (*dst).ProtoReflect().Type().New().Interface().(T)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return err
+	}
+	*dst = msg
+
+	return nil
+}
+
+// GobSerializer serialize values with encoding/gob, every message is encoded with own type description
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type GobSerializer[T any] struct{}
+
+func (GobSerializer[T]) ContentType() string {
+	return ContentTypeGob
+}
+
+func (GobSerializer[T]) Marshal(v T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (GobSerializer[T]) Unmarshal(data []byte, dst *T) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(dst)
+}
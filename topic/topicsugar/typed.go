@@ -0,0 +1,181 @@
+package topicsugar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+// ContentTypeMetadataKey is metadata key of topic message with content type of the message payload
+const ContentTypeMetadataKey = "content-type"
+
+// ErrUnexpectedContentType returns from TypedReader for message with content type other than serializer content type
+var ErrUnexpectedContentType = xerrors.Wrap(errors.New("ydb: unexpected content type of topic message"))
+
+type typedWriterBase interface {
+	Write(ctx context.Context, messages ...topicwriter.Message) error
+	Flush(ctx context.Context) error
+	Close(ctx context.Context) error
+}
+
+// TypedWriter writes values of type T to topic with the serializer
+// and stamps content type of the serializer to metadata of every message
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TypedWriter[T any] struct {
+	writer     typedWriterBase
+	serializer Serializer[T]
+}
+
+// NewTypedWriter create typed writer over the writer
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewTypedWriter[T any](writer *topicwriter.Writer, serializer Serializer[T]) *TypedWriter[T] {
+	return &TypedWriter[T]{
+		writer:     writer,
+		serializer: serializer,
+	}
+}
+
+// Write serialize values and write them to topic as messages, see topicwriter.Writer.Write
+func (w *TypedWriter[T]) Write(ctx context.Context, values ...T) error {
+	messages := make([]topicwriter.Message, len(values))
+	for i := range values {
+		data, err := w.serializer.Marshal(values[i])
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("ydb: failed serialize value of topic message: %w", err))
+		}
+		messages[i] = topicwriter.Message{
+			Data: bytes.NewReader(data),
+			Metadata: map[string][]byte{
+				ContentTypeMetadataKey: []byte(w.serializer.ContentType()),
+			},
+		}
+	}
+
+	return w.writer.Write(ctx, messages...)
+}
+
+// Flush waits till all in-flight messages are acknowledged
+func (w *TypedWriter[T]) Flush(ctx context.Context) error {
+	return w.writer.Flush(ctx)
+}
+
+// Close flush rested messages and close the underlying writer
+func (w *TypedWriter[T]) Close(ctx context.Context) error {
+	return w.writer.Close(ctx)
+}
+
+// TypedMessage is a topic message with deserialized value.
+// It can be committed by reader same as topicreader.Message.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TypedMessage[T any] struct {
+	*topicreader.Message
+
+	Value T
+}
+
+// TypedBatch is a batch of topic messages with deserialized values.
+// It can be committed by reader same as topicreader.Batch.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TypedBatch[T any] struct {
+	*topicreader.Batch
+
+	// Messages with deserialized values, it shadows Batch.Messages with raw messages
+	Messages []*TypedMessage[T]
+}
+
+type typedReaderBase interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	ReadMessagesBatch(ctx context.Context, opts ...topicreader.ReadBatchOption) (*topicreader.Batch, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+// TypedReader reads messages from topic and deserialize them to values of type T.
+// Content type from message metadata validates by the serializer content type,
+// messages without content type in metadata deserialize without validation.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TypedReader[T any] struct {
+	reader     typedReaderBase
+	serializer Serializer[T]
+}
+
+// NewTypedReader create typed reader over the reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewTypedReader[T any](reader *topicreader.Reader, serializer Serializer[T]) *TypedReader[T] {
+	return &TypedReader[T]{
+		reader:     reader,
+		serializer: serializer,
+	}
+}
+
+// ReadMessage read next message and deserialize it.
+// If the message can't be deserialized - error returned with the message for commit or other handling.
+func (r *TypedReader[T]) ReadMessage(ctx context.Context) (*TypedMessage[T], error) {
+	msg, err := r.reader.ReadMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.decode(msg)
+}
+
+// ReadMessagesBatch read batch of messages and deserialize them.
+// If a message can't be deserialized - error returned with the batch for commit or other handling,
+// messages after the failed message are not deserialized.
+func (r *TypedReader[T]) ReadMessagesBatch(
+	ctx context.Context,
+	opts ...topicreader.ReadBatchOption,
+) (*TypedBatch[T], error) {
+	batch, err := r.reader.ReadMessagesBatch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TypedBatch[T]{
+		Batch:    batch,
+		Messages: make([]*TypedMessage[T], 0, len(batch.Messages)),
+	}
+	for _, msg := range batch.Messages {
+		typed, err := r.decode(msg)
+		if err != nil {
+			return res, err
+		}
+		res.Messages = append(res.Messages, typed)
+	}
+
+	return res, nil
+}
+
+// Commit commits TypedMessage, TypedBatch or other object, see topicreader.Reader.Commit
+func (r *TypedReader[T]) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	return r.reader.Commit(ctx, obj)
+}
+
+func (r *TypedReader[T]) decode(msg *topicreader.Message) (*TypedMessage[T], error) {
+	res := &TypedMessage[T]{Message: msg}
+
+	if contentType, ok := msg.Metadata[ContentTypeMetadataKey]; ok && string(contentType) != r.serializer.ContentType() {
+		return res, xerrors.WithStackTrace(fmt.Errorf(
+			"%w: %q, expected: %q", ErrUnexpectedContentType, contentType, r.serializer.ContentType(),
+		))
+	}
+
+	err := ReadMessageDataWithCallback(msg, func(data []byte) error {
+		return r.serializer.Unmarshal(data, &res.Value)
+	})
+	if err != nil {
+		return res, xerrors.WithStackTrace(fmt.Errorf("ydb: failed deserialize topic message: %w", err))
+	}
+
+	return res, nil
+}
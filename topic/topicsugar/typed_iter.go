@@ -0,0 +1,36 @@
+//go:build go1.23
+
+package topicsugar
+
+import (
+	"context"
+	"iter"
+)
+
+// Messages returns infinite iterator over deserialized messages of the reader.
+// Iteration stops after first yielded error or on break of range loop.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *TypedReader[T]) Messages(ctx context.Context) iter.Seq2[*TypedMessage[T], error] {
+	return func(yield func(*TypedMessage[T], error) bool) {
+		for {
+			msg, err := r.ReadMessage(ctx)
+			if !yield(msg, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Values returns iterator over deserialized values of messages in the batch
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (b *TypedBatch[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, msg := range b.Messages {
+			if !yield(msg.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+
+package topicsugar
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+func TestTypedReaderMessages(t *testing.T) {
+	ctx := xtest.Context(t)
+	r := &TypedReader[testTypedValue]{
+		reader: &testTypedReader{messages: []*topicreader.Message{
+			newTestTypedMessage(t, ContentTypeJSON, `{"Name":"a"}`),
+			newTestTypedMessage(t, ContentTypeJSON, `{"Name":"b"}`),
+		}},
+		serializer: JSONSerializer[testTypedValue]{},
+	}
+
+	var names []string
+	for msg, err := range r.Messages(ctx) {
+		if err != nil {
+			require.ErrorIs(t, err, io.EOF)
+
+			break
+		}
+		names = append(names, msg.Value.Name)
+	}
+	require.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestTypedBatchValues(t *testing.T) {
+	batch := &TypedBatch[int]{Messages: []*TypedMessage[int]{{Value: 1}, {Value: 2}, {Value: 3}}}
+
+	var values []int
+	for v := range batch.Values() {
+		if v == 3 {
+			break
+		}
+		values = append(values, v)
+	}
+	require.Equal(t, []int{1, 2}, values)
+}
@@ -0,0 +1,193 @@
+package topicsugar
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+var (
+	_ topicreader.CommitRangeGetter = &TypedMessage[int]{}
+	_ topicreader.CommitRangeGetter = &TypedBatch[int]{}
+)
+
+type testTypedValue struct {
+	Name  string
+	Count int
+}
+
+func TestSerializers(t *testing.T) {
+	value := testTypedValue{Name: "test", Count: 2}
+
+	t.Run("JSON", func(t *testing.T) {
+		var s JSONSerializer[testTypedValue]
+		data, err := s.Marshal(value)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"Name":"test","Count":2}`, string(data))
+
+		var res testTypedValue
+		require.NoError(t, s.Unmarshal(data, &res))
+		require.Equal(t, value, res)
+	})
+	t.Run("Gob", func(t *testing.T) {
+		var s GobSerializer[testTypedValue]
+		data, err := s.Marshal(value)
+		require.NoError(t, err)
+
+		var res testTypedValue
+		require.NoError(t, s.Unmarshal(data, &res))
+		require.Equal(t, value, res)
+	})
+	t.Run("Proto", func(t *testing.T) {
+		var s ProtoSerializer[*wrapperspb.StringValue]
+		data, err := s.Marshal(wrapperspb.String("test"))
+		require.NoError(t, err)
+
+		var res *wrapperspb.StringValue
+		require.NoError(t, s.Unmarshal(data, &res))
+		require.True(t, proto.Equal(wrapperspb.String("test"), res))
+	})
+}
+
+type testTypedWriter struct {
+	messages []topicwriter.Message
+}
+
+func (w *testTypedWriter) Write(ctx context.Context, messages ...topicwriter.Message) error {
+	w.messages = append(w.messages, messages...)
+
+	return nil
+}
+
+func (w *testTypedWriter) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (w *testTypedWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+type testTypedReader struct {
+	messages []*topicreader.Message
+}
+
+func (r *testTypedReader) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	if len(r.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+
+	return msg, nil
+}
+
+func (r *testTypedReader) ReadMessagesBatch(
+	ctx context.Context,
+	opts ...topicreader.ReadBatchOption,
+) (*topicreader.Batch, error) {
+	if len(r.messages) == 0 {
+		return nil, io.EOF
+	}
+	batch := &topicreader.Batch{Messages: r.messages}
+	r.messages = nil
+
+	return batch, nil
+}
+
+func (r *testTypedReader) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	return nil
+}
+
+func newTestTypedMessage(t testing.TB, contentType string, data string) *topicreader.Message {
+	builder := topicreaderinternal.NewPublicMessageBuilder().DataAndUncompressedSize([]byte(data))
+	if contentType != "" {
+		builder.Metadata(map[string][]byte{ContentTypeMetadataKey: []byte(contentType)})
+	}
+
+	return builder.Build()
+}
+
+func TestTypedWriter(t *testing.T) {
+	ctx := xtest.Context(t)
+	base := &testTypedWriter{}
+	w := &TypedWriter[testTypedValue]{writer: base, serializer: JSONSerializer[testTypedValue]{}}
+
+	require.NoError(t, w.Write(ctx, testTypedValue{Name: "a"}, testTypedValue{Name: "b"}))
+	require.Len(t, base.messages, 2)
+	for _, msg := range base.messages {
+		require.Equal(t, ContentTypeJSON, string(msg.Metadata[ContentTypeMetadataKey]))
+	}
+
+	data, err := io.ReadAll(base.messages[1].Data)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Name":"b","Count":0}`, string(data))
+}
+
+func TestTypedReader(t *testing.T) {
+	newReader := func(messages ...*topicreader.Message) *TypedReader[testTypedValue] {
+		return &TypedReader[testTypedValue]{
+			reader:     &testTypedReader{messages: messages},
+			serializer: JSONSerializer[testTypedValue]{},
+		}
+	}
+
+	t.Run("ReadMessage", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newReader(
+			newTestTypedMessage(t, ContentTypeJSON, `{"Name":"a"}`),
+			newTestTypedMessage(t, "", `{"Name":"b"}`),
+		)
+
+		msg, err := r.ReadMessage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "a", msg.Value.Name)
+
+		msg, err = r.ReadMessage(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "b", msg.Value.Name)
+
+		_, err = r.ReadMessage(ctx)
+		require.ErrorIs(t, err, io.EOF)
+	})
+	t.Run("UnexpectedContentType", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newReader(newTestTypedMessage(t, ContentTypeGob, `{"Name":"a"}`))
+
+		msg, err := r.ReadMessage(ctx)
+		require.ErrorIs(t, err, ErrUnexpectedContentType)
+		require.NotNil(t, msg.Message)
+	})
+	t.Run("ReadMessagesBatch", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newReader(
+			newTestTypedMessage(t, ContentTypeJSON, `{"Name":"a"}`),
+			newTestTypedMessage(t, ContentTypeJSON, `{"Name":"b"}`),
+		)
+
+		batch, err := r.ReadMessagesBatch(ctx)
+		require.NoError(t, err)
+		require.Len(t, batch.Messages, 2)
+		require.Equal(t, "a", batch.Messages[0].Value.Name)
+		require.Equal(t, "b", batch.Messages[1].Value.Name)
+	})
+	t.Run("ReadMessagesBatchBadMessage", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newReader(
+			newTestTypedMessage(t, ContentTypeJSON, `{"Name":"a"}`),
+			newTestTypedMessage(t, ContentTypeJSON, `bad json`),
+		)
+
+		batch, err := r.ReadMessagesBatch(ctx)
+		require.Error(t, err)
+		require.Len(t, batch.Messages, 1)
+	})
+}
@@ -19,7 +19,8 @@ const (
 	// CodecLzop not supported by default, customer need provide own codec library
 	CodecLzop = Codec(rawtopiccommon.CodecLzop)
 
-	// CodecZstd not supported by default, customer need provide own codec library
+	// CodecZstd supported by default.
+	// Auto select of codec by writer use it if the codec allowed in supported codecs of the topic.
 	CodecZstd = Codec(rawtopiccommon.CodecZstd)
 
 	CodecCustomerFirst = Codec(rawtopiccommon.CodecCustomerFirst)
@@ -94,20 +95,88 @@ func (m *MeteringMode) ToRaw(raw *rawtopic.MeteringMode) {
 
 // PartitionSettings settings of partitions
 type PartitionSettings struct {
-	MinActivePartitions int64
-	PartitionCountLimit int64
+	MinActivePartitions      int64
+	MaxActivePartitions      int64
+	PartitionCountLimit      int64
+	AutoPartitioningSettings AutoPartitioningSettings
 }
 
 // ToRaw convert public format to internal. Used internally only.
 func (s *PartitionSettings) ToRaw(raw *rawtopic.PartitioningSettings) {
 	raw.MinActivePartitions = s.MinActivePartitions
+	raw.MaxActivePartitions = s.MaxActivePartitions
 	raw.PartitionCountLimit = s.PartitionCountLimit
+	s.AutoPartitioningSettings.ToRaw(&raw.AutoPartitioningSettings)
 }
 
 // FromRaw convert internal format to public. Used internally only.
 func (s *PartitionSettings) FromRaw(raw *rawtopic.PartitioningSettings) {
 	s.MinActivePartitions = raw.MinActivePartitions
+	s.MaxActivePartitions = raw.MaxActivePartitions
 	s.PartitionCountLimit = raw.PartitionCountLimit
+	s.AutoPartitioningSettings.FromRaw(&raw.AutoPartitioningSettings)
+}
+
+// AutoPartitioningStrategy strategy of automatically split and merge partitions of topic
+type AutoPartitioningStrategy int32
+
+const (
+	AutoPartitioningStrategyUnspecified = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyUnspecified)
+
+	// AutoPartitioningStrategyDisabled disables automatically change count of partitions
+	AutoPartitioningStrategyDisabled = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyDisabled)
+
+	// AutoPartitioningStrategyScaleUp allows only split of overloaded partitions
+	AutoPartitioningStrategyScaleUp = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyScaleUp)
+
+	// AutoPartitioningStrategyScaleUpAndDown allows split of overloaded partitions and merge of underloaded
+	AutoPartitioningStrategyScaleUpAndDown = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyScaleUpAndDown)
+
+	// AutoPartitioningStrategyPaused temporary stops change count of partitions
+	AutoPartitioningStrategyPaused = AutoPartitioningStrategy(rawtopic.AutoPartitioningStrategyPaused)
+)
+
+// AutoPartitioningSettings settings of automatically split and merge partitions of topic
+type AutoPartitioningSettings struct {
+	AutoPartitioningStrategy           AutoPartitioningStrategy
+	AutoPartitioningWriteSpeedStrategy AutoPartitioningWriteSpeedStrategy
+}
+
+// ToRaw convert public format to internal. Used internally only.
+func (s *AutoPartitioningSettings) ToRaw(raw *rawtopic.AutoPartitioningSettings) {
+	raw.AutoPartitioningStrategy = rawtopic.AutoPartitioningStrategy(s.AutoPartitioningStrategy)
+	s.AutoPartitioningWriteSpeedStrategy.ToRaw(&raw.AutoPartitioningWriteSpeedStrategy)
+}
+
+// FromRaw convert internal format to public. Used internally only.
+func (s *AutoPartitioningSettings) FromRaw(raw *rawtopic.AutoPartitioningSettings) {
+	s.AutoPartitioningStrategy = AutoPartitioningStrategy(raw.AutoPartitioningStrategy)
+	s.AutoPartitioningWriteSpeedStrategy.FromRaw(&raw.AutoPartitioningWriteSpeedStrategy)
+}
+
+// AutoPartitioningWriteSpeedStrategy thresholds of partition write speed for split and merge partitions.
+// Utilization percents are relative to PartitionWriteSpeedBytesPerSecond of the topic
+type AutoPartitioningWriteSpeedStrategy struct {
+	StabilizationWindow    time.Duration // time of stable load before split or merge, zero means server default
+	UpUtilizationPercent   int32         // write speed utilization for split partition, zero means server default
+	DownUtilizationPercent int32         // write speed utilization for merge partitions, zero means server default
+}
+
+// ToRaw convert public format to internal. Used internally only.
+func (s *AutoPartitioningWriteSpeedStrategy) ToRaw(raw *rawtopic.AutoPartitioningWriteSpeedStrategy) {
+	if s.StabilizationWindow != 0 {
+		raw.StabilizationWindow.HasValue = true
+		raw.StabilizationWindow.Value = s.StabilizationWindow
+	}
+	raw.UpUtilizationPercent = s.UpUtilizationPercent
+	raw.DownUtilizationPercent = s.DownUtilizationPercent
+}
+
+// FromRaw convert internal format to public. Used internally only.
+func (s *AutoPartitioningWriteSpeedStrategy) FromRaw(raw *rawtopic.AutoPartitioningWriteSpeedStrategy) {
+	s.StabilizationWindow = raw.StabilizationWindow.Value
+	s.UpUtilizationPercent = raw.UpUtilizationPercent
+	s.DownUtilizationPercent = raw.DownUtilizationPercent
 }
 
 // TopicDescription contains info about topic.
@@ -123,6 +192,7 @@ type TopicDescription struct {
 	Attributes                        map[string]string
 	Consumers                         []Consumer
 	MeteringMode                      MeteringMode
+	TopicStats                        TopicStats
 }
 
 // FromRaw convert from public format to internal. Used internally only.
@@ -160,6 +230,7 @@ func (d *TopicDescription) FromRaw(raw *rawtopic.DescribeTopicResult) {
 	}
 
 	d.MeteringMode.FromRaw(raw.MeteringMode)
+	d.TopicStats.FromRaw(&raw.TopicStats)
 }
 
 // PartitionInfo contains info about partition.
@@ -168,6 +239,7 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+	PartitionStats     PartitionStats
 }
 
 // FromRaw convert from internal format to public. Used internally only.
@@ -177,4 +249,144 @@ func (p *PartitionInfo) FromRaw(raw *rawtopic.PartitionInfo) {
 
 	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
 	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+
+	p.PartitionStats.FromRaw(&raw.PartitionStats)
+}
+
+// TopicStats contains statistics of topic.
+// Filled only if describe called with topicoptions.IncludeStats
+type TopicStats struct {
+	StoreSizeBytes   int64
+	MinLastWriteTime time.Time     // zero if no messages in topic
+	MaxWriteTimeLag  time.Duration // max lag between client and server write time of messages
+	BytesWritten     MultipleWindowsStat
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *TopicStats) FromRaw(raw *rawtopic.TopicStats) {
+	s.StoreSizeBytes = raw.StoreSizeBytes
+	s.MinLastWriteTime = raw.MinLastWriteTime.Value
+	s.MaxWriteTimeLag = raw.MaxWriteTimeLag.Value
+	s.BytesWritten.FromRaw(&raw.BytesWritten)
+}
+
+// PartitionStats contains statistics of partition.
+// Filled only if describe called with include stats option
+type PartitionStats struct {
+	PartitionsOffset OffsetRange
+	StoreSizeBytes   int64
+	LastWriteTime    time.Time     // zero if no messages in partition
+	MaxWriteTimeLag  time.Duration // max lag between client and server write time of messages
+	BytesWritten     MultipleWindowsStat
+	PartitionNodeID  int32
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *PartitionStats) FromRaw(raw *rawtopic.PartitionStats) {
+	s.PartitionsOffset.Start = raw.PartitionsOffset.Start.ToInt64()
+	s.PartitionsOffset.End = raw.PartitionsOffset.End.ToInt64()
+	s.StoreSizeBytes = raw.StoreSizeBytes
+	s.LastWriteTime = raw.LastWriteTime.Value
+	s.MaxWriteTimeLag = raw.MaxWriteTimeLag.Value
+	s.BytesWritten.FromRaw(&raw.BytesWritten)
+	s.PartitionNodeID = raw.PartitionNodeID
+}
+
+// OffsetRange is a range of offsets [Start, End)
+type OffsetRange struct {
+	Start int64
+	End   int64
+}
+
+// MultipleWindowsStat contains amount of bytes for last minute, hour and day
+type MultipleWindowsStat struct {
+	PerMinute int64
+	PerHour   int64
+	PerDay    int64
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *MultipleWindowsStat) FromRaw(raw *rawtopic.MultipleWindowsStat) {
+	s.PerMinute = raw.PerMinute
+	s.PerHour = raw.PerHour
+	s.PerDay = raw.PerDay
+}
+
+// TopicConsumerDescription contains info about consumer of topic.
+type TopicConsumerDescription struct {
+	Path       string
+	Consumer   Consumer
+	Partitions []DescribeConsumerPartitionInfo
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (d *TopicConsumerDescription) FromRaw(raw *rawtopic.DescribeConsumerResult) {
+	d.Path = raw.Self.Name
+	d.Consumer.FromRaw(&raw.Consumer)
+
+	d.Partitions = make([]DescribeConsumerPartitionInfo, len(raw.Partitions))
+	for i := range raw.Partitions {
+		d.Partitions[i].FromRaw(&raw.Partitions[i])
+	}
+}
+
+// DescribeConsumerPartitionInfo contains info about partition of topic for the consumer.
+type DescribeConsumerPartitionInfo struct {
+	PartitionID            int64
+	Active                 bool
+	ChildPartitionIDs      []int64
+	ParentPartitionIDs     []int64
+	PartitionStats         PartitionStats
+	PartitionConsumerStats PartitionConsumerStats
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (p *DescribeConsumerPartitionInfo) FromRaw(raw *rawtopic.DescribeConsumerResultPartitionInfo) {
+	p.PartitionID = raw.PartitionID
+	p.Active = raw.Active
+
+	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
+	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+
+	p.PartitionStats.FromRaw(&raw.PartitionStats)
+	p.PartitionConsumerStats.FromRaw(&raw.PartitionConsumerStats)
+}
+
+// ReadLag returns count of messages of the partition which are not committed by the consumer yet
+func (p *DescribeConsumerPartitionInfo) ReadLag() int64 {
+	lag := p.PartitionStats.PartitionsOffset.End - p.PartitionConsumerStats.CommittedOffset
+	if lag < 0 {
+		return 0
+	}
+
+	return lag
+}
+
+// PartitionConsumerStats contains statistics of read the partition by the consumer.
+// Filled only if describe called with topicoptions.IncludeConsumerStats
+type PartitionConsumerStats struct {
+	LastReadOffset                 int64
+	CommittedOffset                int64
+	ReadSessionID                  string    // empty if partition not read now
+	PartitionReadSessionCreateTime time.Time // zero if partition not read now
+	LastReadTime                   time.Time
+	MaxReadTimeLag                 time.Duration // max lag between server write time and read time of messages
+	MaxWriteTimeLag                time.Duration // max lag between client and server write time of read messages
+	BytesRead                      MultipleWindowsStat
+	ReaderName                     string
+	ConnectionNodeID               int32
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (s *PartitionConsumerStats) FromRaw(raw *rawtopic.PartitionConsumerStats) {
+	s.LastReadOffset = raw.LastReadOffset
+	s.CommittedOffset = raw.CommittedOffset
+	s.ReadSessionID = raw.ReadSessionID
+	s.PartitionReadSessionCreateTime = raw.PartitionReadSessionCreateTime.Value
+	s.LastReadTime = raw.LastReadTime.Value
+	s.MaxReadTimeLag = raw.MaxReadTimeLag.Value
+	s.MaxWriteTimeLag = raw.MaxWriteTimeLag.Value
+	s.BytesRead.FromRaw(&raw.BytesRead)
+	s.ReaderName = raw.ReaderName
+	s.ConnectionNodeID = raw.ConnectionNodeID
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawscheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
 )
 
 func TestTopicDescriptionFromRaw(t *testing.T) {
@@ -52,7 +53,16 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 				Path: "some/path",
 				PartitionSettings: PartitionSettings{
 					MinActivePartitions: 4,
+					MaxActivePartitions: 8,
 					PartitionCountLimit: 4,
+					AutoPartitioningSettings: AutoPartitioningSettings{
+						AutoPartitioningStrategy: AutoPartitioningStrategyScaleUp,
+						AutoPartitioningWriteSpeedStrategy: AutoPartitioningWriteSpeedStrategy{
+							StabilizationWindow:    time.Minute,
+							UpUtilizationPercent:   80,
+							DownUtilizationPercent: 20,
+						},
+					},
 				},
 				Partitions: []PartitionInfo{
 					{
@@ -92,7 +102,19 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 				},
 				PartitioningSettings: rawtopic.PartitioningSettings{
 					MinActivePartitions: 4,
+					MaxActivePartitions: 8,
 					PartitionCountLimit: 4,
+					AutoPartitioningSettings: rawtopic.AutoPartitioningSettings{
+						AutoPartitioningStrategy: rawtopic.AutoPartitioningStrategyScaleUp,
+						AutoPartitioningWriteSpeedStrategy: rawtopic.AutoPartitioningWriteSpeedStrategy{
+							StabilizationWindow: rawoptional.Duration{
+								Value:    time.Minute,
+								HasValue: true,
+							},
+							UpUtilizationPercent:   80,
+							DownUtilizationPercent: 20,
+						},
+					},
 				},
 				Partitions: []rawtopic.PartitionInfo{
 					{
@@ -162,3 +184,84 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 		})
 	}
 }
+
+func TestTopicConsumerDescriptionFromRaw(t *testing.T) {
+	writeTime := time.Date(2024, time.March, 8, 12, 12, 12, 0, time.UTC)
+	readTime := writeTime.Add(time.Minute)
+
+	raw := &rawtopic.DescribeConsumerResult{
+		Self: rawscheme.Entry{
+			Name: "some/path",
+		},
+		Consumer: rawtopic.Consumer{
+			Name: "consumer",
+		},
+		Partitions: []rawtopic.DescribeConsumerResultPartitionInfo{
+			{
+				PartitionID: 1,
+				Active:      true,
+				PartitionStats: rawtopic.PartitionStats{
+					PartitionsOffset: rawtopicreader.OffsetRange{Start: 10, End: 30},
+					StoreSizeBytes:   1024,
+					LastWriteTime:    rawoptional.Time{Value: writeTime, HasValue: true},
+					MaxWriteTimeLag:  rawoptional.Duration{Value: time.Second, HasValue: true},
+					BytesWritten:     rawtopic.MultipleWindowsStat{PerMinute: 1, PerHour: 2, PerDay: 3},
+					PartitionNodeID:  4,
+				},
+				PartitionConsumerStats: rawtopic.PartitionConsumerStats{
+					LastReadOffset:                 25,
+					CommittedOffset:                20,
+					ReadSessionID:                  "session",
+					PartitionReadSessionCreateTime: rawoptional.Time{Value: writeTime, HasValue: true},
+					LastReadTime:                   rawoptional.Time{Value: readTime, HasValue: true},
+					MaxReadTimeLag:                 rawoptional.Duration{Value: time.Minute, HasValue: true},
+					BytesRead:                      rawtopic.MultipleWindowsStat{PerMinute: 4, PerHour: 5, PerDay: 6},
+					ReaderName:                     "reader",
+					ConnectionNodeID:               5,
+				},
+			},
+		},
+	}
+
+	expected := TopicConsumerDescription{
+		Path: "some/path",
+		Consumer: Consumer{
+			Name:            "consumer",
+			SupportedCodecs: make([]Codec, 0),
+		},
+		Partitions: []DescribeConsumerPartitionInfo{
+			{
+				PartitionID: 1,
+				Active:      true,
+				PartitionStats: PartitionStats{
+					PartitionsOffset: OffsetRange{Start: 10, End: 30},
+					StoreSizeBytes:   1024,
+					LastWriteTime:    writeTime,
+					MaxWriteTimeLag:  time.Second,
+					BytesWritten:     MultipleWindowsStat{PerMinute: 1, PerHour: 2, PerDay: 3},
+					PartitionNodeID:  4,
+				},
+				PartitionConsumerStats: PartitionConsumerStats{
+					LastReadOffset:                 25,
+					CommittedOffset:                20,
+					ReadSessionID:                  "session",
+					PartitionReadSessionCreateTime: writeTime,
+					LastReadTime:                   readTime,
+					MaxReadTimeLag:                 time.Minute,
+					BytesRead:                      MultipleWindowsStat{PerMinute: 4, PerHour: 5, PerDay: 6},
+					ReaderName:                     "reader",
+					ConnectionNodeID:               5,
+				},
+			},
+		},
+	}
+
+	var d TopicConsumerDescription
+	d.FromRaw(raw)
+	if !reflect.DeepEqual(d, expected) {
+		t.Errorf("got\n%+v\nexpected\n %+v", d, expected)
+	}
+	if lag := d.Partitions[0].ReadLag(); lag != 10 {
+		t.Errorf("unexpected read lag: %v", lag)
+	}
+}
@@ -8,6 +8,11 @@ import (
 
 type (
 	Message = topicwriterinternal.PublicMessage
+
+	// MultiWriterMessage is a message of MultiWriter with route to partition by key or explicit partition id
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	MultiWriterMessage = topicwriterinternal.PublicMultiWriterMessage
 )
 
 var ErrQueueLimitExceed = topicwriterinternal.PublicErrQueueIsFull
@@ -76,3 +81,72 @@ func (w *Writer) Close(ctx context.Context) error {
 func (w *Writer) Flush(ctx context.Context) error {
 	return w.inner.Flush(ctx)
 }
+
+// TxWriter writes messages inside the query service transaction.
+// Messages become visible for readers only after commit of the transaction.
+// The writer flushes messages before commit of the transaction automatically
+// and stops after commit or rollback of the transaction.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TxWriter struct {
+	inner *topicwriterinternal.WriterWithTransaction
+}
+
+// NewTxWriter create new transactional writer from internal type. Used internally only.
+func NewTxWriter(writer *topicwriterinternal.WriterWithTransaction) *TxWriter {
+	return &TxWriter{
+		inner: writer,
+	}
+}
+
+// Write send messages to topic inside the transaction.
+// Lazy transaction begins on first write if it is not begun yet.
+//
+// Write must not be called concurrently with other methods of the transaction
+func (w *TxWriter) Write(ctx context.Context, messages ...Message) error {
+	return w.inner.Write(ctx, messages...)
+}
+
+// WaitInit waits until the writer is initialized
+// or an error occurs
+func (w *TxWriter) WaitInit(ctx context.Context) (err error) {
+	_, err = w.inner.WaitInit(ctx)
+
+	return err
+}
+
+// MultiWriter writes messages to many partitions of the topic.
+// It routes every message by hash of the message key or to explicit partition of the message
+// and has own write session for every partition. Messages with same key are written to same partition
+// in order of write. Queue limit of the writer shared between all partitions.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type MultiWriter struct {
+	inner *topicwriterinternal.MultiWriter
+}
+
+// NewMultiWriter create new multi partition writer from internal type. Used internally only.
+func NewMultiWriter(writer *topicwriterinternal.MultiWriter) *MultiWriter {
+	return &MultiWriter{
+		inner: writer,
+	}
+}
+
+// Write send messages to partitions of the topic.
+// Partitions of the topic described on first write, partitions writers start on first message to the partition.
+//
+// If write to one of the partitions failed - messages to other partitions can be written.
+func (w *MultiWriter) Write(ctx context.Context, messages ...MultiWriterMessage) error {
+	return w.inner.Write(ctx, messages...)
+}
+
+// Flush waits till all in-flight messages of all partitions are acknowledged.
+func (w *MultiWriter) Flush(ctx context.Context) error {
+	return w.inner.Flush(ctx)
+}
+
+// Close will flush rested messages from buffers and close writers of all partitions.
+// You can't write new messages after call Close
+func (w *MultiWriter) Close(ctx context.Context) error {
+	return w.inner.Close(ctx)
+}
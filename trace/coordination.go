@@ -60,6 +60,13 @@ type (
 		OnSessionStart func(CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnSessionSend func(CoordinationSessionSendStartInfo) func(CoordinationSessionSendDoneInfo)
+
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		OnElectionCampaign func(CoordinationElectionCampaignStartInfo) func(CoordinationElectionCampaignDoneInfo)
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		OnElectionResign func(CoordinationElectionResignStartInfo) func(CoordinationElectionResignDoneInfo)
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		OnElectionLeadershipLost func(CoordinationElectionLeadershipLostInfo)
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	CoordinationNewStartInfo struct {
@@ -231,4 +238,36 @@ type (
 	CoordinationSessionSendDoneInfo struct {
 		Error error
 	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CoordinationElectionCampaignStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+
+		Name      string
+		SessionID uint64
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CoordinationElectionCampaignDoneInfo struct {
+		Error error
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CoordinationElectionResignStartInfo struct {
+		Call call
+
+		Name      string
+		SessionID uint64
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CoordinationElectionResignDoneInfo struct {
+		Error error
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CoordinationElectionLeadershipLostInfo struct {
+		Name      string
+		SessionID uint64
+	}
 )
@@ -611,6 +611,95 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 			}
 		}
 	}
+	{
+		h1 := t.OnElectionCampaign
+		h2 := x.OnElectionCampaign
+		ret.OnElectionCampaign = func(c CoordinationElectionCampaignStartInfo) func(CoordinationElectionCampaignDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationElectionCampaignDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationElectionCampaignDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnElectionResign
+		h2 := x.OnElectionResign
+		ret.OnElectionResign = func(c CoordinationElectionResignStartInfo) func(CoordinationElectionResignDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationElectionResignDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationElectionResignDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnElectionLeadershipLost
+		h2 := x.OnElectionLeadershipLost
+		ret.OnElectionLeadershipLost = func(c CoordinationElectionLeadershipLostInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Coordination) onNew(c CoordinationNewStartInfo) func(CoordinationNewDoneInfo) {
@@ -848,6 +937,43 @@ func (t *Coordination) onSessionSend(c CoordinationSessionSendStartInfo) func(Co
 	}
 	return res
 }
+func (t *Coordination) onElectionCampaign(c CoordinationElectionCampaignStartInfo) func(CoordinationElectionCampaignDoneInfo) {
+	fn := t.OnElectionCampaign
+	if fn == nil {
+		return func(CoordinationElectionCampaignDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationElectionCampaignDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onElectionResign(c CoordinationElectionResignStartInfo) func(CoordinationElectionResignDoneInfo) {
+	fn := t.OnElectionResign
+	if fn == nil {
+		return func(CoordinationElectionResignDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationElectionResignDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onElectionLeadershipLost(c CoordinationElectionLeadershipLostInfo) {
+	fn := t.OnElectionLeadershipLost
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func CoordinationOnNew(t *Coordination, c *context.Context, call call) func() {
 	var p CoordinationNewStartInfo
@@ -1042,3 +1168,37 @@ func CoordinationOnSessionSend(t *Coordination, request *Ydb_Coordination.Sessio
 		res(p)
 	}
 }
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnElectionCampaign(t *Coordination, c *context.Context, call call, name string, sessionID uint64) func(error) {
+	var p CoordinationElectionCampaignStartInfo
+	p.Context = c
+	p.Call = call
+	p.Name = name
+	p.SessionID = sessionID
+	res := t.onElectionCampaign(p)
+	return func(e error) {
+		var p CoordinationElectionCampaignDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnElectionResign(t *Coordination, call call, name string, sessionID uint64) func(error) {
+	var p CoordinationElectionResignStartInfo
+	p.Call = call
+	p.Name = name
+	p.SessionID = sessionID
+	res := t.onElectionResign(p)
+	return func(e error) {
+		var p CoordinationElectionResignDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnElectionLeadershipLost(t *Coordination, name string, sessionID uint64) {
+	var p CoordinationElectionLeadershipLostInfo
+	p.Name = name
+	p.SessionID = sessionID
+	t.onElectionLeadershipLost(p)
+}
@@ -79,6 +79,8 @@ const (
 
 	CoordinationEvents
 
+	OperationEvents
+
 	DriverEvents = DriverConnEvents |
 		DriverConnStreamEvents |
 		DriverBalancerEvents |
@@ -145,6 +147,8 @@ var (
 
 		RatelimiterEvents: "ydb.ratelimiter",
 
+		OperationEvents: "ydb.operation",
+
 		TableEvents:                     "ydb.table",
 		TableSessionLifeCycleEvents:     "ydb.table.session",
 		TableSessionQueryInvokeEvents:   "ydb.table.session.query.invoke",
@@ -0,0 +1,360 @@
+// Code generated by gtrace. DO NOT EDIT.
+
+package trace
+
+import (
+	"context"
+)
+
+// operationComposeOptions is a holder of options
+type operationComposeOptions struct {
+	panicCallback func(e interface{})
+}
+
+// OperationOption specified Operation compose option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+type OperationComposeOption func(o *operationComposeOptions)
+
+// WithOperationPanicCallback specified behavior on panic
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithOperationPanicCallback(cb func(e interface{})) OperationComposeOption {
+	return func(o *operationComposeOptions) {
+		o.panicCallback = cb
+	}
+}
+
+// Compose returns a new Operation which has functional fields composed both from t and x.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Operation) Compose(x *Operation, opts ...OperationComposeOption) *Operation {
+	var ret Operation
+	options := operationComposeOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	{
+		h1 := t.OnGet
+		h2 := x.OnGet
+		ret.OnGet = func(o OperationGetStartInfo) func(OperationGetDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(OperationGetDoneInfo)
+			if h1 != nil {
+				r = h1(o)
+			}
+			if h2 != nil {
+				r1 = h2(o)
+			}
+			return func(o OperationGetDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(o)
+				}
+				if r1 != nil {
+					r1(o)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnList
+		h2 := x.OnList
+		ret.OnList = func(o OperationListStartInfo) func(OperationListDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(OperationListDoneInfo)
+			if h1 != nil {
+				r = h1(o)
+			}
+			if h2 != nil {
+				r1 = h2(o)
+			}
+			return func(o OperationListDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(o)
+				}
+				if r1 != nil {
+					r1(o)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnCancel
+		h2 := x.OnCancel
+		ret.OnCancel = func(o OperationCancelStartInfo) func(OperationCancelDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(OperationCancelDoneInfo)
+			if h1 != nil {
+				r = h1(o)
+			}
+			if h2 != nil {
+				r1 = h2(o)
+			}
+			return func(o OperationCancelDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(o)
+				}
+				if r1 != nil {
+					r1(o)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnForget
+		h2 := x.OnForget
+		ret.OnForget = func(o OperationForgetStartInfo) func(OperationForgetDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(OperationForgetDoneInfo)
+			if h1 != nil {
+				r = h1(o)
+			}
+			if h2 != nil {
+				r1 = h2(o)
+			}
+			return func(o OperationForgetDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(o)
+				}
+				if r1 != nil {
+					r1(o)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnWait
+		h2 := x.OnWait
+		ret.OnWait = func(o OperationWaitStartInfo) func(OperationWaitDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(OperationWaitDoneInfo)
+			if h1 != nil {
+				r = h1(o)
+			}
+			if h2 != nil {
+				r1 = h2(o)
+			}
+			return func(o OperationWaitDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(o)
+				}
+				if r1 != nil {
+					r1(o)
+				}
+			}
+		}
+	}
+	return &ret
+}
+func (t *Operation) onGet(o OperationGetStartInfo) func(OperationGetDoneInfo) {
+	fn := t.OnGet
+	if fn == nil {
+		return func(OperationGetDoneInfo) {
+			return
+		}
+	}
+	res := fn(o)
+	if res == nil {
+		return func(OperationGetDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Operation) onList(o OperationListStartInfo) func(OperationListDoneInfo) {
+	fn := t.OnList
+	if fn == nil {
+		return func(OperationListDoneInfo) {
+			return
+		}
+	}
+	res := fn(o)
+	if res == nil {
+		return func(OperationListDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Operation) onCancel(o OperationCancelStartInfo) func(OperationCancelDoneInfo) {
+	fn := t.OnCancel
+	if fn == nil {
+		return func(OperationCancelDoneInfo) {
+			return
+		}
+	}
+	res := fn(o)
+	if res == nil {
+		return func(OperationCancelDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Operation) onForget(o OperationForgetStartInfo) func(OperationForgetDoneInfo) {
+	fn := t.OnForget
+	if fn == nil {
+		return func(OperationForgetDoneInfo) {
+			return
+		}
+	}
+	res := fn(o)
+	if res == nil {
+		return func(OperationForgetDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Operation) onWait(o OperationWaitStartInfo) func(OperationWaitDoneInfo) {
+	fn := t.OnWait
+	if fn == nil {
+		return func(OperationWaitDoneInfo) {
+			return
+		}
+	}
+	res := fn(o)
+	if res == nil {
+		return func(OperationWaitDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func OperationOnGet(t *Operation, c *context.Context, call call, operationID string) func(ready bool, status string, _ error) {
+	var p OperationGetStartInfo
+	p.Context = c
+	p.Call = call
+	p.OperationID = operationID
+	res := t.onGet(p)
+	return func(ready bool, status string, e error) {
+		var p OperationGetDoneInfo
+		p.Ready = ready
+		p.Status = status
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func OperationOnList(t *Operation, c *context.Context, call call, kind string, pageSize uint64, pageToken string) func(count int, nextToken string, _ error) {
+	var p OperationListStartInfo
+	p.Context = c
+	p.Call = call
+	p.Kind = kind
+	p.PageSize = pageSize
+	p.PageToken = pageToken
+	res := t.onList(p)
+	return func(count int, nextToken string, e error) {
+		var p OperationListDoneInfo
+		p.Count = count
+		p.NextToken = nextToken
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func OperationOnCancel(t *Operation, c *context.Context, call call, operationID string) func(error) {
+	var p OperationCancelStartInfo
+	p.Context = c
+	p.Call = call
+	p.OperationID = operationID
+	res := t.onCancel(p)
+	return func(e error) {
+		var p OperationCancelDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func OperationOnForget(t *Operation, c *context.Context, call call, operationID string) func(error) {
+	var p OperationForgetStartInfo
+	p.Context = c
+	p.Call = call
+	p.OperationID = operationID
+	res := t.onForget(p)
+	return func(e error) {
+		var p OperationForgetDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func OperationOnWait(t *Operation, c *context.Context, call call, operationID string) func(attempts int, status string, _ error) {
+	var p OperationWaitStartInfo
+	p.Context = c
+	p.Call = call
+	p.OperationID = operationID
+	res := t.onWait(p)
+	return func(attempts int, status string, e error) {
+		var p OperationWaitDoneInfo
+		p.Attempts = attempts
+		p.Status = status
+		p.Error = e
+		res(p)
+	}
+}
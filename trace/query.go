@@ -2,6 +2,8 @@ package trace
 
 import (
 	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
 )
 
 // tool gtrace used from ./internal/cmd/gtrace
@@ -138,6 +140,10 @@ type (
 		Session querySessionInfo
 		Tx      queryTransactionInfo
 		Query   string
+		// BeginInlined is true if transaction begins with this execute instead of separated BeginTransaction call
+		BeginInlined bool
+		// CommitInlined is true if transaction commits with this execute instead of separated CommitTransaction call
+		CommitInlined bool
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxExecuteDoneInfo struct {
@@ -209,6 +215,8 @@ type (
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryResultNextPartDoneInfo struct {
+		// Stats is a query execution statistics from received part or nil if part have no statistics
+		Stats stats.QueryStats
 		Error error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
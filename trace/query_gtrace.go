@@ -4,6 +4,8 @@ package trace
 
 import (
 	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/stats"
 )
 
 // queryComposeOptions is a holder of options
@@ -1460,13 +1462,15 @@ func QueryOnSessionBegin(t *Query, c *context.Context, call call, session queryS
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxExecute(t *Query, c *context.Context, call call, session querySessionInfo, tx queryTransactionInfo, query string) func(error) {
+func QueryOnTxExecute(t *Query, c *context.Context, call call, session querySessionInfo, tx queryTransactionInfo, query string, beginInlined bool, commitInlined bool) func(error) {
 	var p QueryTxExecuteStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Tx = tx
 	p.Query = query
+	p.BeginInlined = beginInlined
+	p.CommitInlined = commitInlined
 	res := t.onTxExecute(p)
 	return func(e error) {
 		var p QueryTxExecuteDoneInfo
@@ -1487,13 +1491,14 @@ func QueryOnResultNew(t *Query, c *context.Context, call call) func(error) {
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnResultNextPart(t *Query, c *context.Context, call call) func(error) {
+func QueryOnResultNextPart(t *Query, c *context.Context, call call) func(stats stats.QueryStats, _ error) {
 	var p QueryResultNextPartStartInfo
 	p.Context = c
 	p.Call = call
 	res := t.onResultNextPart(p)
-	return func(e error) {
+	return func(stats stats.QueryStats, e error) {
 		var p QueryResultNextPartDoneInfo
+		p.Stats = stats
 		p.Error = e
 		res(p)
 	}
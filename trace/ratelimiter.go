@@ -1,5 +1,9 @@
 package trace
 
+import (
+	"context"
+)
+
 // tool gtrace used from ./internal/cmd/gtrace
 
 //go:generate gtrace
@@ -8,5 +12,54 @@ type (
 	// Ratelimiter specified trace of ratelimiter client activity.
 	// gtrace:gen
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-	Ratelimiter struct{}
+	Ratelimiter struct {
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		OnLimiterAcquire func(RatelimiterLimiterAcquireStartInfo) func(RatelimiterLimiterAcquireDoneInfo)
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		OnLimiterWait func(RatelimiterLimiterWaitStartInfo) func(RatelimiterLimiterWaitDoneInfo)
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		OnLimiterAllow func(RatelimiterLimiterAllowInfo)
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RatelimiterLimiterAcquireStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+
+		CoordinationNodePath string
+		ResourcePath         string
+		Amount               uint64
+		Report               bool
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RatelimiterLimiterAcquireDoneInfo struct {
+		Error error
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RatelimiterLimiterWaitStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+
+		CoordinationNodePath string
+		ResourcePath         string
+		Amount               uint64
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RatelimiterLimiterWaitDoneInfo struct {
+		Error error
+	}
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RatelimiterLimiterAllowInfo struct {
+		CoordinationNodePath string
+		ResourcePath         string
+		Amount               uint64
+		Allowed              bool
+	}
 )
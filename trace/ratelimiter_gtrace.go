@@ -2,6 +2,10 @@
 
 package trace
 
+import (
+	"context"
+)
+
 // ratelimiterComposeOptions is a holder of options
 type ratelimiterComposeOptions struct {
 	panicCallback func(e interface{})
@@ -23,5 +27,177 @@ func WithRatelimiterPanicCallback(cb func(e interface{})) RatelimiterComposeOpti
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Ratelimiter) Compose(x *Ratelimiter, opts ...RatelimiterComposeOption) *Ratelimiter {
 	var ret Ratelimiter
+	options := ratelimiterComposeOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	{
+		h1 := t.OnLimiterAcquire
+		h2 := x.OnLimiterAcquire
+		ret.OnLimiterAcquire = func(r RatelimiterLimiterAcquireStartInfo) func(RatelimiterLimiterAcquireDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r1, r2 func(RatelimiterLimiterAcquireDoneInfo)
+			if h1 != nil {
+				r1 = h1(r)
+			}
+			if h2 != nil {
+				r2 = h2(r)
+			}
+			return func(r RatelimiterLimiterAcquireDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r1 != nil {
+					r1(r)
+				}
+				if r2 != nil {
+					r2(r)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnLimiterWait
+		h2 := x.OnLimiterWait
+		ret.OnLimiterWait = func(r RatelimiterLimiterWaitStartInfo) func(RatelimiterLimiterWaitDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r1, r2 func(RatelimiterLimiterWaitDoneInfo)
+			if h1 != nil {
+				r1 = h1(r)
+			}
+			if h2 != nil {
+				r2 = h2(r)
+			}
+			return func(r RatelimiterLimiterWaitDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r1 != nil {
+					r1(r)
+				}
+				if r2 != nil {
+					r2(r)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnLimiterAllow
+		h2 := x.OnLimiterAllow
+		ret.OnLimiterAllow = func(r RatelimiterLimiterAllowInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return &ret
 }
+func (t *Ratelimiter) onLimiterAcquire(r RatelimiterLimiterAcquireStartInfo) func(RatelimiterLimiterAcquireDoneInfo) {
+	fn := t.OnLimiterAcquire
+	if fn == nil {
+		return func(RatelimiterLimiterAcquireDoneInfo) {
+			return
+		}
+	}
+	res := fn(r)
+	if res == nil {
+		return func(RatelimiterLimiterAcquireDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Ratelimiter) onLimiterWait(r RatelimiterLimiterWaitStartInfo) func(RatelimiterLimiterWaitDoneInfo) {
+	fn := t.OnLimiterWait
+	if fn == nil {
+		return func(RatelimiterLimiterWaitDoneInfo) {
+			return
+		}
+	}
+	res := fn(r)
+	if res == nil {
+		return func(RatelimiterLimiterWaitDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Ratelimiter) onLimiterAllow(r RatelimiterLimiterAllowInfo) {
+	fn := t.OnLimiterAllow
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func RatelimiterOnLimiterAcquire(t *Ratelimiter, c *context.Context, call call, coordinationNodePath string, resourcePath string, amount uint64, report bool) func(error) {
+	var p RatelimiterLimiterAcquireStartInfo
+	p.Context = c
+	p.Call = call
+	p.CoordinationNodePath = coordinationNodePath
+	p.ResourcePath = resourcePath
+	p.Amount = amount
+	p.Report = report
+	res := t.onLimiterAcquire(p)
+	return func(e error) {
+		var p RatelimiterLimiterAcquireDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func RatelimiterOnLimiterWait(t *Ratelimiter, c *context.Context, call call, coordinationNodePath string, resourcePath string, amount uint64) func(error) {
+	var p RatelimiterLimiterWaitStartInfo
+	p.Context = c
+	p.Call = call
+	p.CoordinationNodePath = coordinationNodePath
+	p.ResourcePath = resourcePath
+	p.Amount = amount
+	res := t.onLimiterWait(p)
+	return func(e error) {
+		var p RatelimiterLimiterWaitDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func RatelimiterOnLimiterAllow(t *Ratelimiter, coordinationNodePath string, resourcePath string, amount uint64, allowed bool) {
+	var p RatelimiterLimiterAllowInfo
+	p.CoordinationNodePath = coordinationNodePath
+	p.ResourcePath = resourcePath
+	p.Amount = amount
+	p.Allowed = allowed
+	t.onLimiterAllow(p)
+}